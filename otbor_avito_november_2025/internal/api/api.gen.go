@@ -1,419 +1,2924 @@
-﻿// Package api provides primitives to interact with the openapi HTTP API.
-//
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
-package api
-
-import (
-	"bytes"
-	"compress/gzip"
-	"encoding/base64"
-	"fmt"
-	"net/http"
-	"net/url"
-	"path"
-	"strings"
-	"time"
-
-	"github.com/getkin/kin-openapi/openapi3"
-	"github.com/labstack/echo/v4"
-	"github.com/oapi-codegen/runtime"
-)
-
-// Defines values for ErrorResponseErrorCode.
-const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
-)
-
-// Defines values for PullRequestStatus.
-const (
-	PullRequestStatusMERGED PullRequestStatus = "MERGED"
-	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
-)
-
-// Defines values for PullRequestShortStatus.
-const (
-	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
-	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
-)
-
-// ErrorResponse defines model for ErrorResponse.
-type ErrorResponse struct {
-	Error struct {
-		Code    ErrorResponseErrorCode `json:"code"`
-		Message string                 `json:"message"`
-	} `json:"error"`
-}
-
-// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
-type ErrorResponseErrorCode string
-
-// PullRequest defines model for PullRequest.
-type PullRequest struct {
-	// AssignedReviewers user_id ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╜╤Л╤Е ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
-}
-
-// PullRequestStatus defines model for PullRequest.Status.
-type PullRequestStatus string
-
-// PullRequestShort defines model for PullRequestShort.
-type PullRequestShort struct {
-	AuthorId        string                 `json:"author_id"`
-	PullRequestId   string                 `json:"pull_request_id"`
-	PullRequestName string                 `json:"pull_request_name"`
-	Status          PullRequestShortStatus `json:"status"`
-}
-
-// PullRequestShortStatus defines model for PullRequestShort.Status.
-type PullRequestShortStatus string
-
-// Team defines model for Team.
-type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
-}
-
-// TeamMember defines model for TeamMember.
-type TeamMember struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
-}
-
-// User defines model for User.
-type User struct {
-	IsActive bool   `json:"is_active"`
-	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
-}
-
-// TeamNameQuery defines model for TeamNameQuery.
-type TeamNameQuery = string
-
-// UserIdQuery defines model for UserIdQuery.
-type UserIdQuery = string
-
-// PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
-type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
-}
-
-// PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
-type PostPullRequestMergeJSONBody struct {
-	PullRequestId string `json:"pull_request_id"`
-}
-
-// PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
-type PostPullRequestReassignJSONBody struct {
-	OldUserId     string `json:"old_user_id"`
-	PullRequestId string `json:"pull_request_id"`
-}
-
-// GetTeamGetParams defines parameters for GetTeamGet.
-type GetTeamGetParams struct {
-	// TeamName ╨г╨╜╨╕╨║╨░╨╗╤М╨╜╨╛╨╡ ╨╕╨╝╤П ╨║╨╛╨╝╨░╨╜╨┤╤Л
-	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
-}
-
-// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
-type GetUsersGetReviewParams struct {
-	// UserId ╨Ш╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤П
-	UserId UserIdQuery `form:"user_id" json:"user_id"`
-}
-
-// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
-type PostUsersSetIsActiveJSONBody struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
-}
-
-// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
-type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
-
-// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
-type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
-
-// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
-type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
-
-// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
-type PostTeamAddJSONRequestBody = Team
-
-// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
-type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
-
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// ╨б╨╛╨╖╨┤╨░╤В╤М PR ╨╕ ╨░╨▓╤В╨╛╨╝╨░╤В╨╕╤З╨╡╤Б╨║╨╕ ╨╜╨░╨╖╨╜╨░╤З╨╕╤В╤М ╨┤╨╛ 2 ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ ╨╕╨╖ ╨║╨╛╨╝╨░╨╜╨┤╤Л ╨░╨▓╤В╨╛╤А╨░
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(ctx echo.Context) error
-	// ╨Я╨╛╨╝╨╡╤В╨╕╤В╤М PR ╨║╨░╨║ MERGED (╨╕╨┤╨╡╨╝╨┐╨╛╤В╨╡╨╜╤В╨╜╨░╤П ╨╛╨┐╨╡╤А╨░╤Ж╨╕╤П)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(ctx echo.Context) error
-	// ╨Я╨╡╤А╨╡╨╜╨░╨╖╨╜╨░╤З╨╕╤В╤М ╨║╨╛╨╜╨║╤А╨╡╤В╨╜╨╛╨│╨╛ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨░ ╨╜╨░ ╨┤╤А╤Г╨│╨╛╨│╨╛ ╨╕╨╖ ╨╡╨│╨╛ ╨║╨╛╨╝╨░╨╜╨┤╤Л
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(ctx echo.Context) error
-	// ╨б╨╛╨╖╨┤╨░╤В╤М ╨║╨╛╨╝╨░╨╜╨┤╤Г ╤Б ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨░╨╝╨╕ (╤Б╨╛╨╖╨┤╨░╤С╤В/╨╛╨▒╨╜╨╛╨▓╨╗╤П╨╡╤В ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╨╡╨╣)
-	// (POST /team/add)
-	PostTeamAdd(ctx echo.Context) error
-	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М ╨║╨╛╨╝╨░╨╜╨┤╤Г ╤Б ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨░╨╝╨╕
-	// (GET /team/get)
-	GetTeamGet(ctx echo.Context, params GetTeamGetParams) error
-	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М PR'╤Л, ╨│╨┤╨╡ ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤М ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨╝
-	// (GET /users/getReview)
-	GetUsersGetReview(ctx echo.Context, params GetUsersGetReviewParams) error
-	// ╨г╤Б╤В╨░╨╜╨╛╨▓╨╕╤В╤М ╤Д╨╗╨░╨│ ╨░╨║╤В╨╕╨▓╨╜╨╛╤Б╤В╨╕ ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤П
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(ctx echo.Context) error
-}
-
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
-}
-
-// PostPullRequestCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) PostPullRequestCreate(ctx echo.Context) error {
-	var err error
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPullRequestCreate(ctx)
-	return err
-}
-
-// PostPullRequestMerge converts echo context to params.
-func (w *ServerInterfaceWrapper) PostPullRequestMerge(ctx echo.Context) error {
-	var err error
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPullRequestMerge(ctx)
-	return err
-}
-
-// PostPullRequestReassign converts echo context to params.
-func (w *ServerInterfaceWrapper) PostPullRequestReassign(ctx echo.Context) error {
-	var err error
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPullRequestReassign(ctx)
-	return err
-}
-
-// PostTeamAdd converts echo context to params.
-func (w *ServerInterfaceWrapper) PostTeamAdd(ctx echo.Context) error {
-	var err error
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostTeamAdd(ctx)
-	return err
-}
-
-// GetTeamGet converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTeamGet(ctx echo.Context) error {
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
-	// ------------- Required query parameter "team_name" -------------
-
-	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
-	}
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTeamGet(ctx, params)
-	return err
-}
-
-// GetUsersGetReview converts echo context to params.
-func (w *ServerInterfaceWrapper) GetUsersGetReview(ctx echo.Context) error {
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUsersGetReviewParams
-	// ------------- Required query parameter "user_id" -------------
-
-	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
-	}
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetUsersGetReview(ctx, params)
-	return err
-}
-
-// PostUsersSetIsActive converts echo context to params.
-func (w *ServerInterfaceWrapper) PostUsersSetIsActive(ctx echo.Context) error {
-	var err error
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostUsersSetIsActive(ctx)
-	return err
-}
-
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-}
-
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
-}
-
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
-
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
-
-	router.POST(baseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
-	router.POST(baseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
-	router.POST(baseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
-	router.POST(baseURL+"/team/add", wrapper.PostTeamAdd)
-	router.GET(baseURL+"/team/get", wrapper.GetTeamGet)
-	router.GET(baseURL+"/users/getReview", wrapper.GetUsersGetReview)
-	router.POST(baseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
-
-}
-
-// Base64 encoded, gzipped, json marshaled Swagger object
-var swaggerSpec = []string{
-
-	"H4sIAAAAAAAC/9xabW8bxxH+K4ttgTgALVK0XaD8xtiKqg+WWYoGigqCsOKtpEvuzXd7agSDgF7Suq2M",
-	"qvlWBEiMwH+ApsWK1gv1F2b/UTG7R94deTxRpiwX+WJTx73d2dlnnnlmli9p07U91+GOCGjlJfWYz2wu",
-	"uK/+anBmLzOb/zHk/i4+MHjQ9E1PmK5DKxTewiX04AzacC5fwyX0oUugBxfymMAZ9OEC2nAJJ/KIFqiJ",
-	"b7xQExWow2xOK1RwZq+rzwXq8xeh6XODVoQf8gINmtvcZrio2PVwcCB809mirVaBPg+4v2RMsuo/cAJd",
-	"uJQH0JPfa/vkAfTlHoEr6CtTT6EPHfW4C+fyeIJ5YcD9ddO4kXGtwZfKgQu+7/p1HniuE3B8wL9jtmfp",
-	"j/gdfmi6Bk6x/Kyx/vWz58tPaIHaPAjYFj71eeCGfpMTxxVk0w0dQ3nA812P+8LkQWqq9GM98UvKndCm",
-	"lVXaWKg+XV/409JKY4UWaK2e+vx0ob64gGujHdWVlaXF5ejP9cfV5SdLT6qNhehbbeVaYXTzCbuzTi12",
-	"4qo2LR4fz+VufMObYmy83uH4sAKthZZV5y9CHohxD7AgMLccbqz7fMfkf4lgncZLdMoELqENp/ivfIX4",
-	"gUt5JP9K5B50oSNfy39BB7pyD5FD7pXm5spfImwEt4OM7Q4NZb7PdvFvFoptV8Epa3TT50xwo6r2sOn6",
-	"NhO0Qg0m+H1hqvhwQstiGwgdDcEM3/tbs83ghZa17mtfTjI0NUbHScaoQDARBknsPastLNMCjVA2jp2R",
-	"8x41JWvhpE+HSxayzvwa3Kxsu34WeHJP7NfgrCy/IOeP+8Lm9kYUPUPI/9bnm7RCf1OMU0gx4r4izvJU",
-	"vZMVCzHvX0sUyRQxMGKS2dGCY8abwTprCnMnudyG61qcOfjqgOazzga/m87QOFkM3ykkVs6yGdPYja3N",
-	"890n3UvyJPL2hZOZzqarljEF8g2t1Uk9ikZSVfFpc0eQFe7vmE1O7jV4IEiDBd8WyNfMski5VH6E9LrD",
-	"/UCz9Pxcaa6Eu3A97jDPpBX6YK409wDBzsS28lzRi2O6qBlVudfVqQGdzJD0lww0yQ1EggMe6+HaDzwQ",
-	"X7nGrs6hjuCOep95nmU21QzFbwK0KpXPE3RBw3mawRDU8+/Pl0rzmQFaoVXDIAFnfnObtpIS43Ow0owM",
-	"k42KtIpSD7QyUhsrl+Zv5nDPn5TiV2lYRvA+QENu81xistYc3co5KG1eHkkmpUurlemytFSp1Ynchz6c",
-	"wgkqazzMh6WHU3gttjHPnrRazVgf/g0dLaWLSX0PbRRPXa2gPkTq+0hb9/ubnemoKE6K1FgU1+rENAiz",
-	"fM6MXcK/MwMRjJzFTPtEPx/Cf6FL5L48lP+ArtyXB9CRh9CVB3ql0LYZlh8UfhmciDyQr0mtTqBHoK09",
-	"hS5ShcgrnAPO8Kukzuypd+AE+qScLTWhB6cj1dRwdrkHbWRmtqVAn8BTQNfQyhQjKoE4NSE+VaNn4MPJ",
-	"YZYXNNfy1zXM9HHMU7ob5oklOsUMd3++dL/8sDFfrjx4WHn0uz/fGjdFwvHu2Qk6iqBUtPTlsWoP9MjA",
-	"nDtmK7RnlJZGY/eNiquuCtFB9J5BG84io8k96Kk3L+AK+qpfcCkPVPAeE+jDlYrTtvwb9OTxl9PHos81",
-	"eqYOx/rghRki0rVirEaoLOdiLgc/OFee2Jw5kAupJT5/WKPSDB99ckGBe/As1uTG+gYiNHxEby+KRybP",
-	"6YT0oQN9eA/98aSkMk7+Ufo0vdLaFOwBb9Ts3bE2TA9DuCOPdPMOIxrt+yxs0oNzTODZXcTXWWxzQwkU",
-	"1dqYJZTpMVH9pNeAU+SdC0VDx1o7IC/twzl0ybCFt8OscJKcGg6K5VSTOY4ryICTiOsQbQOp1bUrHPcx",
-	"cwzTiCqqtF3yQAkYJH15CFdR30zRKIrDnpZG6Ks800b6jLF1jkt0rUkiSKnSsTmwh5gOwdJ0YKioRvE7",
-	"Yuib3EN7J4/gfKwFmKXILvI3keqdJtu4UfVrBqqTOyAZIlwits0g8vTtSVj4CdpyTx7Kv8dBdKKT3bC1",
-	"CVcYztBBXJMolWXEnzwez5rjQyMli0L1Es7wa5UnJ5GI8jWBE7QRh6hhWut29efRC4SczIrnX2SGkZ9N",
-	"G5zZVcOYJYMO22CrqT6N7qcO06HOCnG7hVYts8lpq5D/Ujn90lfuBm2tpRo+1GO7trqqmRoojWFo3HL1",
-	"LaI+4ed2yQZrfsujW5FJaXJg6xSOmiZT/ZgqfZMVObQ15Zdmq3rTFzUxiwz3/Qlr39HdfWwdnIrfQyL3",
-	"iTyUr6CtZhhcG15Aj9yLHSh/kAdF6MO7SIGcy2OdXjIzLnThQ1Jy4wmmGGGLK+dH/6UJYZErPljkQnUQ",
-	"41vP1Wz/xUOK6VtRxONssvP/JoJuzikj0PkZ3sl/QhfO8MzS/H3nraof8/tTGKnjpeC5wmjvRgCegEB0",
-	"eoAQ1I3vPCA+x6GLw5E3xWPyNnx2NCYLFr38J6131kbQOmVvaPqbqLF7voz7qMmF7MSLkbQxUxU4v8AV",
-	"9BTZnZFa/Qvd3Zv0i4RrwFmrfyGPCgTeI5pzK5KpBO0AwAqJKQAHXCwF1eF91GR1pV5dSYyeQWYlCG2T",
-	"WQGfHiMffdU38aCvu+q65R5EGN0Jjrsgi7KvpfocVw1WygsedUM5nSj6OZG1f9AlBnyYiMy7zwdvpi/a",
-	"06H3VhF+O9qcDj/5PZxDG94TaGOygx508Hs1spf3M6OxQGsNn70c/OxIZxFM7NEDPTjxIFUCJZ7/gTNL",
-	"bNPWWut/AQAA//80B9xs2CUAAA==",
-}
-
-// GetSwagger returns the content of the embedded swagger specification file
-// or error if failed to decode
-func decodeSpec() ([]byte, error) {
-	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
-	if err != nil {
-		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
-	}
-	zr, err := gzip.NewReader(bytes.NewReader(zipped))
-	if err != nil {
-		return nil, fmt.Errorf("error decompressing spec: %w", err)
-	}
-	var buf bytes.Buffer
-	_, err = buf.ReadFrom(zr)
-	if err != nil {
-		return nil, fmt.Errorf("error decompressing spec: %w", err)
-	}
-
-	return buf.Bytes(), nil
-}
-
-var rawSpec = decodeSpecCached()
-
-// a naive cached of a decoded swagger spec
-func decodeSpecCached() func() ([]byte, error) {
-	data, err := decodeSpec()
-	return func() ([]byte, error) {
-		return data, err
-	}
-}
-
-// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
-func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
-	res := make(map[string]func() ([]byte, error))
-	if len(pathToFile) > 0 {
-		res[pathToFile] = rawSpec
-	}
-
-	return res
-}
-
-// GetSwagger returns the Swagger specification corresponding to the generated code
-// in this file. The external references of Swagger specification are resolved.
-// The logic of resolving external references is tightly connected to "import-mapping" feature.
-// Externally referenced files must be embedded in the corresponding golang packages.
-// Urls can be supported but this task was out of the scope.
-func GetSwagger() (swagger *openapi3.T, err error) {
-	resolvePath := PathToRawSpec("")
-
-	loader := openapi3.NewLoader()
-	loader.IsExternalRefsAllowed = true
-	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
-		pathToFile := url.String()
-		pathToFile = path.Clean(pathToFile)
-		getSpec, ok := resolvePath[pathToFile]
-		if !ok {
-			err1 := fmt.Errorf("path not found: %s", pathToFile)
-			return nil, err1
-		}
-		return getSpec()
-	}
-	var specData []byte
-	specData, err = rawSpec()
-	if err != nil {
-		return
-	}
-	swagger, err = loader.LoadFromData(specData)
-	if err != nil {
-		return
-	}
-	return
-}
+﻿// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	ApiKeyAuthScopes = "apiKeyAuth.Scopes"
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for ErrorResponseErrorCode.
+const (
+	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
+	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+)
+
+// Defines values for PullRequestStatus.
+const (
+	PullRequestStatusCLOSED PullRequestStatus = "CLOSED"
+	PullRequestStatusMERGED PullRequestStatus = "MERGED"
+	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
+)
+
+// Defines values for PullRequestShortStatus.
+const (
+	PullRequestShortStatusCLOSED PullRequestShortStatus = "CLOSED"
+	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
+	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
+)
+
+// Defines values for BucketQuery.
+const (
+	Day  BucketQuery = "day"
+	Week BucketQuery = "week"
+)
+
+// Defines values for GetAdminReviewExportParamsFormat.
+const (
+	Csv   GetAdminReviewExportParamsFormat = "csv"
+	Jsonl GetAdminReviewExportParamsFormat = "jsonl"
+)
+
+// Defines values for GetUsersGetReviewParamsStatus.
+const (
+	GetUsersGetReviewParamsStatusCLOSED GetUsersGetReviewParamsStatus = "CLOSED"
+	GetUsersGetReviewParamsStatusMERGED GetUsersGetReviewParamsStatus = "MERGED"
+	GetUsersGetReviewParamsStatusOPEN   GetUsersGetReviewParamsStatus = "OPEN"
+)
+
+// Defines values for PostPullRequestCreateJSONBodyPriority.
+const (
+	HIGH   PostPullRequestCreateJSONBodyPriority = "HIGH"
+	NORMAL PostPullRequestCreateJSONBodyPriority = "NORMAL"
+)
+
+// Approval defines model for Approval.
+type Approval struct {
+	// ApprovedAt ╨Ъ╨╛╨│╨┤╨░ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А ╨╛╨┤╨╛╨▒╤А╨╕╨╗ PR
+	ApprovedAt time.Time `json:"approved_at"`
+	UserId     string    `json:"user_id"`
+}
+
+// AssignedReviewer defines model for AssignedReviewer.
+type AssignedReviewer struct {
+	// LoadAtAssignment ╨Ъ╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ OPEN PR ╨╜╨░ ╤А╨╡╨▓╤М╤О ╤Г ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤П ╨▓ ╨╝╨╛╨╝╨╡╨╜╤В ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╤П
+	LoadAtAssignment int    `json:"load_at_assignment"`
+	UserId           string `json:"user_id"`
+	Username         string `json:"username"`
+}
+
+// AssignmentExplanation defines model for AssignmentExplanation.
+type AssignmentExplanation struct {
+	AssignedAt  time.Time `json:"assigned_at"`
+	Detail      string    `json:"detail"`
+	Explanation string    `json:"explanation"`
+
+	// LoadAtAssignment ╨Ъ╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ OPEN PR ╨╜╨░ ╤А╨╡╨▓╤М╤О ╤Г ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤П ╨▓ ╨╝╨╛╨╝╨╡╨╜╤В ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╤П
+	LoadAtAssignment *int `json:"load_at_assignment"`
+
+	// Reason pool, path_owner, related_fallback, cross_team_fallback, reassignment, escalation ╨╕╨╗╨╕ rebalance; ╨┐╤Г╤Б╤В╨╛ ╨┤╨╗╤П ╤Б╤В╨░╤А╤Л╤Е ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╨╣
+	Reason string `json:"reason"`
+
+	// Strategy ╨б╤В╤А╨░╤В╨╡╨│╨╕╤П ╨▓╤Л╨▒╨╛╤А╨░ (RANDOM, WEIGHTED, LEAST_LOADED)
+	Strategy string `json:"strategy"`
+	UserId   string `json:"user_id"`
+}
+
+// AssignmentPreview defines model for AssignmentPreview.
+type AssignmentPreview struct {
+	CurrentReviewers []string `json:"current_reviewers"`
+
+	// ProposedReviewers ╨Ъ╨╛╨│╨╛ ╨▒╤Л ╨╜╨░╨╖╨╜╨░╤З╨╕╨╗╨╕ ╨┐╤А╨╕ ╨╜╨╛╨▓╤Л╤Е ╨╜╨░╤Б╤В╤А╨╛╨╣╨║╨░╤Е
+	ProposedReviewers []string         `json:"proposed_reviewers"`
+	PullRequest       PullRequestShort `json:"pull_request"`
+
+	// Unfilled ╨б╨║╨╛╨╗╤М╨║╨╛ ╨╝╨╡╤Б╤В ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ ╨╛╤Б╤В╨░╨╗╨╛╤Б╤М ╨▒╤Л ╨╜╨╡╨╖╨░╨┐╨╛╨╗╨╜╨╡╨╜╨╜╤Л╨╝╨╕
+	Unfilled int `json:"unfilled"`
+}
+
+// AssignmentTrend defines model for AssignmentTrend.
+type AssignmentTrend struct {
+	Bucket   string                 `json:"bucket"`
+	Points   []AssignmentTrendPoint `json:"points"`
+	Since    time.Time              `json:"since"`
+	TeamName string                 `json:"team_name"`
+}
+
+// AssignmentTrendPoint defines model for AssignmentTrendPoint.
+type AssignmentTrendPoint struct {
+	Assignments int       `json:"assignments"`
+	BucketStart time.Time `json:"bucket_start"`
+}
+
+// BlackoutWindow defines model for BlackoutWindow.
+type BlackoutWindow struct {
+	EndsAt time.Time `json:"ends_at"`
+	Id     int64     `json:"id"`
+
+	// Recurrence none тАФ ╨╛╨┤╨╜╨╛╨║╤А╨░╤В╨╜╨╛, weekly тАФ ╨┐╨╛╨▓╤В╨╛╤А╤П╨╡╤В╤Б╤П ╨║╨░╨╢╨┤╤Г╤О ╨╜╨╡╨┤╨╡╨╗╤О
+	Recurrence string    `json:"recurrence"`
+	StartsAt   time.Time `json:"starts_at"`
+	TeamName   string    `json:"team_name"`
+}
+
+// CoverageGap defines model for CoverageGap.
+type CoverageGap struct {
+	AssignedReviewers int    `json:"assigned_reviewers"`
+	AuthorId          string `json:"author_id"`
+
+	// EligibleCandidates ╨Р╨║╤В╨╕╨▓╨╜╤Л╨╡ ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨╕ ╨║╨╛╨╝╨░╨╜╨┤╤Л, ╨║╨╛╤В╨╛╤А╤Л╤Е ╨╡╤Й╤С ╨╝╨╛╨╢╨╜╨╛ ╨╜╨░╨╖╨╜╨░╤З╨╕╤В╤М
+	EligibleCandidates int  `json:"eligible_candidates"`
+	HasCandidates      bool `json:"has_candidates"`
+
+	// MissingReviewers ╨б╨║╨╛╨╗╤М╨║╨╛ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ ╨╜╨╡ ╤Е╨▓╨░╤В╨░╨╡╤В ╨┤╨╛ ╤В╤А╨╡╨▒╤Г╨╡╨╝╨╛╨│╨╛ ╨║╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨░
+	MissingReviewers int    `json:"missing_reviewers"`
+	PullRequestId    string `json:"pull_request_id"`
+	PullRequestName  string `json:"pull_request_name"`
+}
+
+// CrossTeamReviewer defines model for CrossTeamReviewer.
+type CrossTeamReviewer struct {
+	// Reviews ╨Э╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╤П ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨╝ ╨╜╨░ PR ╨░╨▓╤В╨╛╤А╨╛╨▓ ╨╕╨╖ ╨┤╤А╤Г╨│╨╕╤Е ╨║╨╛╨╝╨░╨╜╨┤
+	Reviews  int    `json:"reviews"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// CrossTeamReviews defines model for CrossTeamReviews.
+type CrossTeamReviews struct {
+	Members  []CrossTeamReviewer `json:"members"`
+	Since    time.Time           `json:"since"`
+	TeamName string              `json:"team_name"`
+
+	// Total ╨б╤Г╨╝╨╝╨░ reviews ╨┐╨╛ ╨▓╤Б╨╡╨╝ ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨░╨╝
+	Total int `json:"total"`
+}
+
+// EndpointInfo defines model for EndpointInfo.
+type EndpointInfo struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+
+	// RequiresAdmin ╨в╤А╨╡╨▒╤Г╨╡╤В╤Б╤П ╨╗╨╕ ╨░╨┤╨╝╨╕╨╜╤Б╨║╨╕╨╣ ╤В╨╛╨║╨╡╨╜
+	RequiresAdmin bool `json:"requires_admin"`
+}
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Error struct {
+		Code    ErrorResponseErrorCode `json:"code"`
+		Message string                 `json:"message"`
+	} `json:"error"`
+}
+
+// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
+type ErrorResponseErrorCode string
+
+// FeatureFlag defines model for FeatureFlag.
+type FeatureFlag struct {
+	Enabled bool   `json:"enabled"`
+	Name    string `json:"name"`
+
+	// Source ╨Ю╤В╨║╤Г╨┤╨░ ╨▓╨╖╤П╤В╨╛ ╨╖╨╜╨░╤З╨╡╨╜╨╕╨╡: default, settings ╨╕╨╗╨╕ env
+	Source string `json:"source"`
+}
+
+// HighChurnPR defines model for HighChurnPR.
+type HighChurnPR struct {
+	// AssignedReviewers ╨в╨╡╨║╤Г╤Й╨╕╨╡ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╤Л
+	AssignedReviewers []string         `json:"assigned_reviewers"`
+	PullRequest       PullRequestShort `json:"pull_request"`
+
+	// Reassignments ╨б╨║╨╛╨╗╤М╨║╨╛ ╤А╨░╨╖ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╤Л PR ╨┐╨╡╤А╨╡╨╜╨░╨╖╨╜╨░╤З╨░╨╗╨╕╤Б╤М
+	Reassignments int `json:"reassignments"`
+}
+
+// ImbalanceAlert defines model for ImbalanceAlert.
+type ImbalanceAlert struct {
+	MaxLoad     int          `json:"max_load"`
+	MeanLoad    float64      `json:"mean_load"`
+	Overloaded  []MemberLoad `json:"overloaded"`
+	TeamName    string       `json:"team_name"`
+	Underloaded []MemberLoad `json:"underloaded"`
+}
+
+// InactiveAssignment defines model for InactiveAssignment.
+type InactiveAssignment struct {
+	// InactiveReviewers user_id ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╜╤Л╤Е ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ ╤Б is_active=false
+	InactiveReviewers []string         `json:"inactive_reviewers"`
+	PullRequest       PullRequestShort `json:"pull_request"`
+}
+
+// Leaderboard defines model for Leaderboard.
+type Leaderboard struct {
+	Entries  []LeaderboardEntry `json:"entries"`
+	Since    time.Time          `json:"since"`
+	TeamName string             `json:"team_name"`
+
+	// Total ╨Ю╨▒╤Й╨╡╨╡ ╨║╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨╛╨▓ ╨║╨╛╨╝╨░╨╜╨┤╤Л
+	Total int `json:"total"`
+}
+
+// LeaderboardEntry defines model for LeaderboardEntry.
+type LeaderboardEntry struct {
+	Rank int `json:"rank"`
+
+	// Reviews ╨Ъ╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ ╤А╨╡╨▓╤М╤О ╨▓ PR, ╤Б╨╝╤С╤А╨╢╨╡╨╜╨╜╤Л╤Е ╨╖╨░ ╨┐╨╡╤А╨╕╨╛╨┤
+	Reviews  int    `json:"reviews"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// MemberChange defines model for MemberChange.
+type MemberChange struct {
+	After  TeamMember `json:"after"`
+	Before TeamMember `json:"before"`
+	UserId string     `json:"user_id"`
+}
+
+// MemberLoad defines model for MemberLoad.
+type MemberLoad struct {
+	OpenReviews int    `json:"open_reviews"`
+	UserId      string `json:"user_id"`
+	Username    string `json:"username"`
+}
+
+// MemberReviewStats defines model for MemberReviewStats.
+type MemberReviewStats struct {
+	// MergedReviews ╨Э╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╤П ╨╜╨░ PR ╨▓ ╤Б╤В╨░╤В╤Г╤Б╨╡ MERGED
+	MergedReviews int `json:"merged_reviews"`
+
+	// OpenReviews ╨Э╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╤П ╨╜╨░ PR ╨▓ ╤Б╤В╨░╤В╤Г╤Б╨╡ OPEN
+	OpenReviews int    `json:"open_reviews"`
+	UserId      string `json:"user_id"`
+	Username    string `json:"username"`
+}
+
+// OwnershipRule defines model for OwnershipRule.
+type OwnershipRule struct {
+	// Owners user_id ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨╛╨▓ ╨║╨╛╨╝╨░╨╜╨┤╤Л
+	Owners []string `json:"owners"`
+
+	// Pattern ╨Я╤Г╤В╤М ╨▓ ╤Б╤В╨╕╨╗╨╡ CODEOWNERS: glob (*.go, docs/*.md) ╨╕╨╗╨╕ ╨║╨░╤В╨░╨╗╨╛╨│ (internal/store/, api/**)
+	Pattern string `json:"pattern"`
+}
+
+// PRStatusFix defines model for PRStatusFix.
+type PRStatusFix struct {
+	MergedAt         *time.Time `json:"merged_at"`
+	PreviousMergedAt *time.Time `json:"previous_merged_at"`
+	PullRequestId    string     `json:"pull_request_id"`
+	Status           string     `json:"status"`
+}
+
+// PendingAssignment defines model for PendingAssignment.
+type PendingAssignment struct {
+	// Attempts ╨б╨║╨╛╨╗╤М╨║╨╛ ╨┐╨╛╨┐╤Л╤В╨╛╨║ ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╤П ╤Г╨╢╨╡ ╤Б╨┤╨╡╨╗╨░╨╜╨╛
+	Attempts  int       `json:"attempts"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// ExpiresAt ╨Я╨╛╤Б╨╗╨╡ ╤Н╤В╨╛╨│╨╛ ╨╝╨╛╨╝╨╡╨╜╤В╨░ ╨┐╨╛╨┐╤Л╤В╨║╨╕ ╨┐╤А╨╡╨║╤А╨░╤Й╨░╤О╤В╤Б╤П
+	ExpiresAt     time.Time `json:"expires_at"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	PullRequestId string    `json:"pull_request_id"`
+	TeamName      string    `json:"team_name"`
+}
+
+// PlaceholderUser defines model for PlaceholderUser.
+type PlaceholderUser struct {
+	// PullRequests ╨Ъ╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ PR, ╤Б╤Б╤Л╨╗╨░╤О╤Й╨╕╤Е╤Б╤П ╨╜╨░ ╤Н╤В╨╛╨│╨╛ ╨░╨▓╤В╨╛╤А╨░
+	PullRequests int `json:"pull_requests"`
+
+	// TeamName ╨Ъ╨╛╨╝╨░╨╜╨┤╨░ PR ╨░╨▓╤В╨╛╤А╨░ ╨╕╨╗╨╕ legacy, ╨╡╤Б╨╗╨╕ ╤Г PR ╨║╨╛╨╝╨░╨╜╨┤╨░ ╨╜╨╡ ╤Г╨║╨░╨╖╨░╨╜╨░
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
+// PoolTrend defines model for PoolTrend.
+type PoolTrend struct {
+	Bucket string `json:"bucket"`
+
+	// Points ╨Я╨╛╤Б╨╗╨╡╨┤╨╜╨╕╨╣ ╤Б╨╜╨╕╨╝╨╛╨║ ╨▓ ╨║╨░╨╢╨┤╨╛╨╝ ╨╕╨╜╤В╨╡╤А╨▓╨░╨╗╨╡; ╨╕╨╜╤В╨╡╤А╨▓╨░╨╗╤Л ╨▒╨╡╨╖ ╤Б╨╜╨╕╨╝╨║╨╛╨▓ ╨┐╤А╨╛╨┐╤Г╤Б╨║╨░╤О╤В╤Б╤П
+	Points   []PoolTrendPoint `json:"points"`
+	Since    time.Time        `json:"since"`
+	TeamName string           `json:"team_name"`
+}
+
+// PoolTrendPoint defines model for PoolTrendPoint.
+type PoolTrendPoint struct {
+	ActiveMembers int `json:"active_members"`
+
+	// AvailableMembers ╨Р╨║╤В╨╕╨▓╨╜╤Л╨╡ ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨╕, ╤Г ╨║╨╛╤В╨╛╤А╤Л╤Е ╨╝╨╡╨╜╤М╤И╨╡ 5 OPEN PR ╨╜╨░ ╤А╨╡╨▓╤М╤О
+	AvailableMembers int       `json:"available_members"`
+	BucketStart      time.Time `json:"bucket_start"`
+}
+
+// PullRequest defines model for PullRequest.
+type PullRequest struct {
+	// Approvals ╨Ю╨┤╨╛╨▒╤А╨╡╨╜╨╕╤П ╤В╨╡╨║╤Г╤Й╨╕╤Е ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓; ╨╛╨┤╨╛╨▒╤А╨╡╨╜╨╕╤П ╤Б╨╜╤П╤В╤Л╤Е ╤Б PR ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ ╨╜╨╡ ╤Г╤З╨╕╤В╤Л╨▓╨░╤О╤В╤Б╤П
+	Approvals *[]Approval `json:"approvals,omitempty"`
+
+	// AssignedReviewers user_id ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╜╤Л╤Е ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ (0..2)
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	CreatedAt         *time.Time `json:"createdAt"`
+	MergedAt          *time.Time `json:"mergedAt"`
+	PullRequestId     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+
+	// ReviewDeadline ╨б╤А╨╛╨║ ╤А╨╡╨▓╤М╤О; ╨┐╨╛╤Б╨╗╨╡ ╨╜╨╡╨│╨╛ PR ╤Н╤Б╨║╨░╨╗╨╕╤А╤Г╨╡╤В╤Б╤П ╨┤╨╛╨┐╨╛╨╗╨╜╨╕╤В╨╡╨╗╤М╨╜╤Л╨╝ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨╝
+	ReviewDeadline *time.Time        `json:"review_deadline"`
+	Status         PullRequestStatus `json:"status"`
+
+	// TeamName ╨Ъ╨╛╨╝╨░╨╜╨┤╨░ ╨░╨▓╤В╨╛╤А╨░ ╨╜╨░ ╨╝╨╛╨╝╨╡╨╜╤В ╤Б╨╛╨╖╨┤╨░╨╜╨╕╤П PR
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// PullRequestStatus defines model for PullRequest.Status.
+type PullRequestStatus string
+
+// PullRequestShort defines model for PullRequestShort.
+type PullRequestShort struct {
+	AuthorId        string                 `json:"author_id"`
+	PullRequestId   string                 `json:"pull_request_id"`
+	PullRequestName string                 `json:"pull_request_name"`
+	Status          PullRequestShortStatus `json:"status"`
+}
+
+// PullRequestShortStatus defines model for PullRequestShort.Status.
+type PullRequestShortStatus string
+
+// ReassignImpact defines model for ReassignImpact.
+type ReassignImpact struct {
+	AuthorId string `json:"author_id"`
+
+	// Candidates ╨б╨║╨╛╨╗╤М╨║╨╛ ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨╛╨▓ ╤Б╨╡╨╣╤З╨░╤Б ╨╝╨╛╨│╤Г╤В ╨╖╨░╨╝╨╡╨╜╨╕╤В╤М ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨░; 0 тАФ PR ╨╛╤Б╤В╨░╨╜╨╡╤В╤Б╤П ╨▒╨╡╨╖ ╨╖╨░╨╝╨╡╨╜╤Л
+	Candidates      int    `json:"candidates"`
+	PullRequestId   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+}
+
+// ReassignmentEvent defines model for ReassignmentEvent.
+type ReassignmentEvent struct {
+	NewUserId  string    `json:"new_user_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+
+	// OldUserId ╨Ъ╨╛╨│╨╛ ╨╖╨░╨╝╨╡╨╜╨╕╨╗╨╕; null тАФ ╨┐╨╡╤А╨▓╨╕╤З╨╜╨╛╨╡ ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╨╡
+	OldUserId *string `json:"old_user_id"`
+
+	// Reason ╨Я╤А╨╕╤З╨╕╨╜╨░ ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╤П ╨╜╨╛╨▓╨╛╨│╨╛ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨░
+	Reason *string `json:"reason,omitempty"`
+}
+
+// RebalanceSwap defines model for RebalanceSwap.
+type RebalanceSwap struct {
+	FromUserId    string `json:"from_user_id"`
+	PullRequestId string `json:"pull_request_id"`
+	ToUserId      string `json:"to_user_id"`
+}
+
+// ReviewAssignment defines model for ReviewAssignment.
+type ReviewAssignment struct {
+	AssignedAt  time.Time        `json:"assigned_at"`
+	PullRequest PullRequestShort `json:"pull_request"`
+}
+
+// ReviewReassignment defines model for ReviewReassignment.
+type ReviewReassignment struct {
+	PullRequestId string `json:"pull_request_id"`
+	ReplacedBy    string `json:"replaced_by"`
+}
+
+// ReviewerAcknowledgement defines model for ReviewerAcknowledgement.
+type ReviewerAcknowledgement struct {
+	// AcknowledgedAt ╨Ъ╨╛╨│╨┤╨░ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А ╨╛╤В╨╝╨╡╤В╨╕╨╗, ╤З╤В╨╛ ╤Г╨▓╨╕╨┤╨╡╨╗ ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╨╡; null тАФ ╨╡╤Й╤С ╨╜╨╡ ╨╛╤В╨╝╨╡╤В╨╕╨╗
+	AcknowledgedAt *time.Time `json:"acknowledged_at"`
+	UserId         string     `json:"user_id"`
+}
+
+// SLACompliance defines model for SLACompliance.
+type SLACompliance struct {
+	// Compliant PR, ╨╜╨░╨▒╤А╨░╨▓╤И╨╕╨╡ ╤В╤А╨╡╨▒╤Г╨╡╨╝╨╛╨╡ ╤З╨╕╤Б╨╗╨╛ ╨╛╨┤╨╛╨▒╤А╨╡╨╜╨╕╨╣ ╨╜╨╡ ╨┐╨╛╨╖╨╢╨╡ review_deadline
+	Compliant int `json:"compliant"`
+
+	// Percent ╨Ф╨╛╨╗╤П compliant ╨╛╤В total ╨▓ ╨┐╤А╨╛╤Ж╨╡╨╜╤В╨░╤Е; null, ╨╡╤Б╨╗╨╕ total = 0
+	Percent  *float64  `json:"percent"`
+	Since    time.Time `json:"since"`
+	TeamName string    `json:"team_name"`
+
+	// Total PR ╤Б review_deadline, ╤Б╨╛╨╖╨┤╨░╨╜╨╜╤Л╨╡ ╨╖╨░ ╨┐╨╡╤А╨╕╨╛╨┤, ╤Г ╨║╨╛╤В╨╛╤А╤Л╤Е ╤Б╤А╨╛╨║ ╤Г╨╢╨╡ ╨╕╤Б╤В╤С╨║ ╨╕╨╗╨╕ ╨║╨╛╤В╨╛╤А╤Л╨╡ ╤Г╨╢╨╡ ╨┐╤А╨╛╤И╨╗╨╕ ╤А╨╡╨▓╤М╤О ╨╕╨╗╨╕ ╤Б╨╝╤С╤А╨╢╨╡╨╜╤Л
+	Total int `json:"total"`
+}
+
+// SelfReview defines model for SelfReview.
+type SelfReview struct {
+	AssignedAt time.Time `json:"assigned_at"`
+
+	// AuthorId ╨Р╨▓╤В╨╛╤А PR, ╨║╨╛╤В╨╛╤А╤Л╨╣ ╨╛╨║╨░╨╖╨░╨╗╤Б╤П ╤Б╤А╨╡╨┤╨╕ ╨╡╨│╨╛ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓
+	AuthorId      string    `json:"author_id"`
+	CreatedAt     time.Time `json:"created_at"`
+	PullRequestId string    `json:"pull_request_id"`
+	Status        string    `json:"status"`
+}
+
+// SnapshotAssignment defines model for SnapshotAssignment.
+type SnapshotAssignment struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
+// StrategyOutcome defines model for StrategyOutcome.
+type StrategyOutcome struct {
+	// Assignments ╨Э╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╤П ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓, ╨▓╤Л╨┐╨╛╨╗╨╜╨╡╨╜╨╜╤Л╨╡ ╤Н╤В╨╛╨╣ ╤Б╤В╤А╨░╤В╨╡╨│╨╕╨╡╨╣
+	Assignments int `json:"assignments"`
+
+	// AvgFirstReviewSeconds ╨б╤А╨╡╨┤╨╜╨╡╨╡ ╨▓╤А╨╡╨╝╤П ╨╛╤В ╤Б╨╛╨╖╨┤╨░╨╜╨╕╤П PR ╨┤╨╛ ╨┐╨╡╤А╨▓╨╛╨│╨╛ ╨┐╨╛╨┤╤В╨▓╨╡╤А╨╢╨┤╨╡╨╜╨╕╤П ╤А╨╡╨▓╤М╤О, null ╨╡╤Б╨╗╨╕ ╨┐╨╛╨┤╤В╨▓╨╡╤А╨╢╨┤╨╡╨╜╨╕╨╣ ╨╜╨╡ ╨▒╤Л╨╗╨╛
+	AvgFirstReviewSeconds *float64 `json:"avg_first_review_seconds"`
+
+	// LoadVariance ╨Ф╨╕╤Б╨┐╨╡╤А╤Б╨╕╤П ╨╜╨░╨│╤А╤Г╨╖╨║╨╕ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ ╨▓ ╨╝╨╛╨╝╨╡╨╜╤В ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╤П
+	LoadVariance *float64 `json:"load_variance"`
+	PullRequests int      `json:"pull_requests"`
+	Strategy     string   `json:"strategy"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	// FallbackTeams ╨Ъ╨╛╨╝╨░╨╜╨┤╤Л, ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨╕ ╨║╨╛╤В╨╛╤А╤Л╤Е ╨╜╨░╨╖╨╜╨░╤З╨░╤О╤В╤Б╤П ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨░╨╝╨╕, ╨╡╤Б╨╗╨╕ ╨▓ ╤Б╨▓╨╛╨╡╨╣ ╨║╨╛╨╝╨░╨╜╨┤╨╡ ╨╜╨╡ ╤Е╨▓╨░╤В╨░╨╡╤В ╨║╨░╨╜╨┤╨╕╨┤╨░╤В╨╛╨▓
+	FallbackTeams *[]string    `json:"fallback_teams,omitempty"`
+	Members       []TeamMember `json:"members"`
+
+	// RequiredReviewers ╨б╨║╨╛╨╗╤М╨║╨╛ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ ╨╜╨░╨╖╨╜╨░╤З╨░╤В╤М ╨╜╨░ PR ╨║╨╛╨╝╨░╨╜╨┤╤Л; ╨╡╤Б╨╗╨╕ ╨░╨║╤В╨╕╨▓╨╜╤Л╤Е ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨╛╨▓ ╨╝╨╡╨╜╤М╤И╨╡, ╨╜╨░╨╖╨╜╨░╤З╨░╤О╤В╤Б╤П ╨▓╤Б╨╡ ╨┤╨╛╤Б╤В╤Г╨┐╨╜╤Л╨╡
+	RequiredReviewers *int   `json:"required_reviewers,omitempty"`
+	TeamName          string `json:"team_name"`
+}
+
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	IsActive bool `json:"is_active"`
+
+	// IsAvailable ╨Р╨║╤В╨╕╨▓╨╡╨╜ ╨╕ ╨╜╨╡ ╨┐╨╡╤А╨╡╨│╤А╤Г╨╢╨╡╨╜ ╤А╨╡╨▓╤М╤О (╤В╨╛╨╗╤М╨║╨╛ ╨┐╤А╨╕ expand=load)
+	IsAvailable *bool `json:"is_available,omitempty"`
+
+	// OpenReviews ╨Ъ╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ OPEN PR ╨╜╨░ ╤А╨╡╨▓╤М╤О (╤В╨╛╨╗╤М╨║╨╛ ╨┐╤А╨╕ expand=load)
+	OpenReviews *int   `json:"open_reviews,omitempty"`
+	UserId      string `json:"user_id"`
+	Username    string `json:"username"`
+}
+
+// TeamSize defines model for TeamSize.
+type TeamSize struct {
+	Members  int    `json:"members"`
+	TeamName string `json:"team_name"`
+}
+
+// TeamSnapshot defines model for TeamSnapshot.
+type TeamSnapshot struct {
+	Assignments []SnapshotAssignment `json:"assignments"`
+	Members     []TeamMember         `json:"members"`
+
+	// SchemaVersion ╨Т╨╡╤А╤Б╨╕╤П ╤Д╨╛╤А╨╝╨░╤В╨░ ╤Б╨╜╨╕╨╝╨║╨░ (╨┐╨╛╨┤╨┤╨╡╤А╨╢╨╕╨▓╨░╨╡╤В╤Б╤П 1)
+	SchemaVersion int    `json:"schema_version"`
+	TeamName      string `json:"team_name"`
+}
+
+// TeamSnapshotDiff defines model for TeamSnapshotDiff.
+type TeamSnapshotDiff struct {
+	AddedAssignments   []SnapshotAssignment `json:"added_assignments"`
+	AddedMembers       []TeamMember         `json:"added_members"`
+	ModifiedMembers    []MemberChange       `json:"modified_members"`
+	RemovedAssignments []SnapshotAssignment `json:"removed_assignments"`
+	RemovedMembers     []TeamMember         `json:"removed_members"`
+}
+
+// TeamStats defines model for TeamStats.
+type TeamStats struct {
+	Members  []MemberReviewStats `json:"members"`
+	TeamName string              `json:"team_name"`
+}
+
+// User defines model for User.
+type User struct {
+	IsActive bool   `json:"is_active"`
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// UserAssignmentCount defines model for UserAssignmentCount.
+type UserAssignmentCount struct {
+	Assignments int    `json:"assignments"`
+	TeamName    string `json:"team_name"`
+	UserId      string `json:"user_id"`
+	Username    string `json:"username"`
+}
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Events ╨Я╨╡╤А╨╡╤Е╨╛╨┤╤Л ╤Б╤В╨░╤В╤Г╤Б╨░ ╨▓ ╤Д╨╛╤А╨╝╨░╤В╨╡ FROM->TO; * ╨╛╨╖╨╜╨░╤З╨░╨╡╤В ╨╗╤О╨▒╨╛╨╣ ╤Б╤В╨░╤В╤Г╤Б
+	Events []string `json:"events"`
+	Id     int64    `json:"id"`
+	Url    string   `json:"url"`
+}
+
+// WebhookTestResult defines model for WebhookTestResult.
+type WebhookTestResult struct {
+	// Error ╨Ю╤И╨╕╨▒╨║╨░ ╤Б╨╛╨╡╨┤╨╕╨╜╨╡╨╜╨╕╤П ╨╕╨╗╨╕ ╤В╨░╨╣╨╝╨░╤Г╤В╨░
+	Error     *string `json:"error"`
+	LatencyMs int64   `json:"latency_ms"`
+
+	// StatusCode HTTP-╤Б╤В╨░╤В╤Г╤Б ╨╛╤В╨▓╨╡╤В╨░ ╨┐╨╛╨┤╨┐╨╕╤Б╤З╨╕╨║╨░, null ╨╡╤Б╨╗╨╕ ╨╖╨░╨┐╤А╨╛╤Б ╨╜╨╡ ╤Г╨┤╨░╨╗╤Б╤П
+	StatusCode     *int  `json:"status_code"`
+	SubscriptionId int64 `json:"subscription_id"`
+}
+
+// BucketQuery defines model for BucketQuery.
+type BucketQuery string
+
+// FieldsQuery defines model for FieldsQuery.
+type FieldsQuery = string
+
+// LimitQuery defines model for LimitQuery.
+type LimitQuery = int
+
+// OffsetQuery defines model for OffsetQuery.
+type OffsetQuery = int
+
+// PRTeamNameQuery defines model for PRTeamNameQuery.
+type PRTeamNameQuery = string
+
+// PullRequestIdQuery defines model for PullRequestIdQuery.
+type PullRequestIdQuery = string
+
+// SinceQuery defines model for SinceQuery.
+type SinceQuery = time.Time
+
+// StrictQuery defines model for StrictQuery.
+type StrictQuery = bool
+
+// TeamNameQuery defines model for TeamNameQuery.
+type TeamNameQuery = string
+
+// UntilQuery defines model for UntilQuery.
+type UntilQuery = time.Time
+
+// UserIdQuery defines model for UserIdQuery.
+type UserIdQuery = string
+
+// PostAdminAssignmentQueueRetryJSONBody defines parameters for PostAdminAssignmentQueueRetry.
+type PostAdminAssignmentQueueRetryJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+
+	// TeamName ╨Ъ╨╛╨╝╨░╨╜╨┤╨░ PR; ╨╡╤Б╨╗╨╕ ╤Г╨║╨░╨╖╨░╨╜╨░, PR ╨╕╤Й╨╡╤В╤Б╤П ╤В╨╛╨╗╤М╨║╨╛ ╤Б╤А╨╡╨┤╨╕ PR ╤Н╤В╨╛╨╣ ╨║╨╛╨╝╨░╨╜╨┤╤Л. ╨Ю╨▒╤П╨╖╨░╤В╨╡╨╗╤М╨╜╨░, ╨╡╤Б╨╗╨╕ PR ╤Б ╤В╨░╨║╨╕╨╝ ╨╕╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А╨╛╨╝ ╨╡╤Б╤В╤М ╨▓ ╨╜╨╡╤Б╨║╨╛╨╗╤М╨║╨╕╤Е ╨║╨╛╨╝╨░╨╜╨┤╨░╤Е
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// GetAdminFairnessParams defines parameters for GetAdminFairness.
+type GetAdminFairnessParams struct {
+	// Since ╨Э╨░╤З╨░╨╗╨╛ ╨┐╨╡╤А╨╕╨╛╨┤╨░ (╨┐╨╛ ╤Г╨╝╨╛╨╗╤З╨░╨╜╨╕╤О 30 ╨┤╨╜╨╡╨╣ ╨╜╨░╨╖╨░╨┤)
+	Since *SinceQuery `form:"since,omitempty" json:"since,omitempty"`
+}
+
+// GetAdminHighChurnPrsParams defines parameters for GetAdminHighChurnPrs.
+type GetAdminHighChurnPrsParams struct {
+	// MinReassigns ╨Ь╨╕╨╜╨╕╨╝╨░╨╗╤М╨╜╨╛╨╡ ╨║╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ ╨┐╨╡╤А╨╡╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╨╣ (╨▓╨║╨╗╤О╤З╨╕╤В╨╡╨╗╤М╨╜╨╛)
+	MinReassigns *int `form:"min_reassigns,omitempty" json:"min_reassigns,omitempty"`
+
+	// Limit ╨Ь╨░╨║╤Б╨╕╨╝╨░╨╗╤М╨╜╨╛╨╡ ╨║╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ ╨╖╨░╨┐╨╕╤Б╨╡╨╣ ╨▓ ╨╛╤В╨▓╨╡╤В╨╡
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset ╨Ъ╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ ╨┐╤А╨╛╨┐╤Г╤Б╨║╨░╨╡╨╝╤Л╤Е ╨╖╨░╨┐╨╕╤Б╨╡╨╣
+	Offset *OffsetQuery `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Fields ╨б╨┐╨╕╤Б╨╛╨║ ╨┐╨╛╨╗╨╡╨╣ ╤З╨╡╤А╨╡╨╖ ╨╖╨░╨┐╤П╤В╤Г╤О, ╨║╨╛╤В╨╛╤А╤Л╨╡ ╨╜╤Г╨╢╨╜╨╛ ╨╛╤Б╤В╨░╨▓╨╕╤В╤М ╨▓ ╨║╨░╨╢╨┤╨╛╨╝ ╨╛╨▒╤К╨╡╨║╤В╨╡ ╨╛╤В╨▓╨╡╤В╨░
+	Fields *FieldsQuery `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Strict ╨Ю╤В╨▓╨╡╤З╨░╤В╤М 400 ╨╜╨░ ╨╜╨╡╨╕╨╖╨▓╨╡╤Б╤В╨╜╤Л╨╡ ╨╕╨╝╨╡╨╜╨░ ╨▓ fields ╨▓╨╝╨╡╤Б╤В╨╛ ╤В╨╛╨│╨╛, ╤З╤В╨╛╨▒╤Л ╨╕╤Е ╨╕╨│╨╜╨╛╤А╨╕╤А╨╛╨▓╨░╤В╤М
+	Strict *StrictQuery `form:"strict,omitempty" json:"strict,omitempty"`
+}
+
+// GetAdminImbalanceAlertsParams defines parameters for GetAdminImbalanceAlerts.
+type GetAdminImbalanceAlertsParams struct {
+	// Factor ╨Т╨╛ ╤Б╨║╨╛╨╗╤М╨║╨╛ ╤А╨░╨╖ ╨╝╨░╨║╤Б╨╕╨╝╨░╨╗╤М╨╜╨░╤П ╨╜╨░╨│╤А╤Г╨╖╨║╨░ ╨┤╨╛╨╗╨╢╨╜╨░ ╨┐╤А╨╡╨▓╤Л╤И╨░╤В╤М ╤Б╤А╨╡╨┤╨╜╤О╤О
+	Factor *float64 `form:"factor,omitempty" json:"factor,omitempty"`
+}
+
+// PostAdminIntegrityPrStatusParams defines parameters for PostAdminIntegrityPrStatus.
+type PostAdminIntegrityPrStatusParams struct {
+	// DryRun ╨в╨╛╨╗╤М╨║╨╛ ╨┐╨╛╨║╨░╨╖╨░╤В╤М ╨╜╨░╨╣╨┤╨╡╨╜╨╜╤Л╨╡ PR, ╨╜╨╕╤З╨╡╨│╨╛ ╨╜╨╡ ╨╕╨╖╨╝╨╡╨╜╤П╤П
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetAdminOldestPendingParams defines parameters for GetAdminOldestPending.
+type GetAdminOldestPendingParams struct {
+	// Limit ╨Ь╨░╨║╤Б╨╕╨╝╨░╨╗╤М╨╜╨╛╨╡ ╨║╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ ╨╖╨░╨┐╨╕╤Б╨╡╨╣ ╨▓ ╨╛╤В╨▓╨╡╤В╨╡
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Fields ╨б╨┐╨╕╤Б╨╛╨║ ╨┐╨╛╨╗╨╡╨╣ ╤З╨╡╤А╨╡╨╖ ╨╖╨░╨┐╤П╤В╤Г╤О, ╨║╨╛╤В╨╛╤А╤Л╨╡ ╨╜╤Г╨╢╨╜╨╛ ╨╛╤Б╤В╨░╨▓╨╕╤В╤М ╨▓ ╨║╨░╨╢╨┤╨╛╨╝ ╨╛╨▒╤К╨╡╨║╤В╨╡ ╨╛╤В╨▓╨╡╤В╨░
+	Fields *FieldsQuery `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Strict ╨Ю╤В╨▓╨╡╤З╨░╤В╤М 400 ╨╜╨░ ╨╜╨╡╨╕╨╖╨▓╨╡╤Б╤В╨╜╤Л╨╡ ╨╕╨╝╨╡╨╜╨░ ╨▓ fields ╨▓╨╝╨╡╤Б╤В╨╛ ╤В╨╛╨│╨╛, ╤З╤В╨╛╨▒╤Л ╨╕╤Е ╨╕╨│╨╜╨╛╤А╨╕╤А╨╛╨▓╨░╤В╤М
+	Strict *StrictQuery `form:"strict,omitempty" json:"strict,omitempty"`
+}
+
+// GetAdminReviewExportParams defines parameters for GetAdminReviewExport.
+type GetAdminReviewExportParams struct {
+	// Since ╨Э╨░╤З╨░╨╗╨╛ ╨┐╨╡╤А╨╕╨╛╨┤╨░ (╨┐╨╛ ╤Г╨╝╨╛╨╗╤З╨░╨╜╨╕╤О 30 ╨┤╨╜╨╡╨╣ ╨╜╨░╨╖╨░╨┤)
+	Since *SinceQuery `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until ╨Ъ╨╛╨╜╨╡╤Ж ╨┐╨╡╤А╨╕╨╛╨┤╨░ (╨┐╨╛ ╤Г╨╝╨╛╨╗╤З╨░╨╜╨╕╤О ╤В╨╡╨║╤Г╤Й╨╕╨╣ ╨╝╨╛╨╝╨╡╨╜╤В)
+	Until *UntilQuery `form:"until,omitempty" json:"until,omitempty"`
+
+	// Format ╨д╨╛╤А╨╝╨░╤В ╨▓╤Л╨│╤А╤Г╨╖╨║╨╕
+	Format *GetAdminReviewExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetAdminReviewExportParamsFormat defines parameters for GetAdminReviewExport.
+type GetAdminReviewExportParamsFormat string
+
+// GetAdminStrategyOutcomesParams defines parameters for GetAdminStrategyOutcomes.
+type GetAdminStrategyOutcomesParams struct {
+	// Since ╨Э╨░╤З╨░╨╗╨╛ ╨┐╨╡╤А╨╕╨╛╨┤╨░ (╨┐╨╛ ╤Г╨╝╨╛╨╗╤З╨░╨╜╨╕╤О 30 ╨┤╨╜╨╡╨╣ ╨╜╨░╨╖╨░╨┤)
+	Since *SinceQuery `form:"since,omitempty" json:"since,omitempty"`
+}
+
+// GetAdminTeamsParams defines parameters for GetAdminTeams.
+type GetAdminTeamsParams struct {
+	// MinMembers ╨Ь╨╕╨╜╨╕╨╝╨░╨╗╤М╨╜╨╛╨╡ ╨║╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨╛╨▓ (╨▓╨║╨╗╤О╤З╨╕╤В╨╡╨╗╤М╨╜╨╛)
+	MinMembers *int `form:"min_members,omitempty" json:"min_members,omitempty"`
+
+	// MaxMembers ╨Ь╨░╨║╤Б╨╕╨╝╨░╨╗╤М╨╜╨╛╨╡ ╨║╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨╛╨▓ (╨▓╨║╨╗╤О╤З╨╕╤В╨╡╨╗╤М╨╜╨╛)
+	MaxMembers *int `form:"max_members,omitempty" json:"max_members,omitempty"`
+
+	// Limit ╨Ь╨░╨║╤Б╨╕╨╝╨░╨╗╤М╨╜╨╛╨╡ ╨║╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ ╨╖╨░╨┐╨╕╤Б╨╡╨╣ ╨▓ ╨╛╤В╨▓╨╡╤В╨╡
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset ╨Ъ╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ ╨┐╤А╨╛╨┐╤Г╤Б╨║╨░╨╡╨╝╤Л╤Е ╨╖╨░╨┐╨╕╤Б╨╡╨╣
+	Offset *OffsetQuery `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Fields ╨б╨┐╨╕╤Б╨╛╨║ ╨┐╨╛╨╗╨╡╨╣ ╤З╨╡╤А╨╡╨╖ ╨╖╨░╨┐╤П╤В╤Г╤О, ╨║╨╛╤В╨╛╤А╤Л╨╡ ╨╜╤Г╨╢╨╜╨╛ ╨╛╤Б╤В╨░╨▓╨╕╤В╤М ╨▓ ╨║╨░╨╢╨┤╨╛╨╝ ╨╛╨▒╤К╨╡╨║╤В╨╡ ╨╛╤В╨▓╨╡╤В╨░
+	Fields *FieldsQuery `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Strict ╨Ю╤В╨▓╨╡╤З╨░╤В╤М 400 ╨╜╨░ ╨╜╨╡╨╕╨╖╨▓╨╡╤Б╤В╨╜╤Л╨╡ ╨╕╨╝╨╡╨╜╨░ ╨▓ fields ╨▓╨╝╨╡╤Б╤В╨╛ ╤В╨╛╨│╨╛, ╤З╤В╨╛╨▒╤Л ╨╕╤Е ╨╕╨│╨╜╨╛╤А╨╕╤А╨╛╨▓╨░╤В╤М
+	Strict *StrictQuery `form:"strict,omitempty" json:"strict,omitempty"`
+}
+
+// PostAdminWebhooksJSONBody defines parameters for PostAdminWebhooks.
+type PostAdminWebhooksJSONBody struct {
+	Events []string `json:"events"`
+	Secret string   `json:"secret"`
+	Url    string   `json:"url"`
+}
+
+// PostAdminWebhooksDeleteJSONBody defines parameters for PostAdminWebhooksDelete.
+type PostAdminWebhooksDeleteJSONBody struct {
+	Id int64 `json:"id"`
+}
+
+// PatchPullRequestJSONBody defines parameters for PatchPullRequest.
+type PatchPullRequestJSONBody struct {
+	PullRequestId   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+
+	// TeamName ╨Ъ╨╛╨╝╨░╨╜╨┤╨░ PR; ╨╡╤Б╨╗╨╕ ╤Г╨║╨░╨╖╨░╨╜╨░, PR ╨╕╤Й╨╡╤В╤Б╤П ╤В╨╛╨╗╤М╨║╨╛ ╤Б╤А╨╡╨┤╨╕ PR ╤Н╤В╨╛╨╣ ╨║╨╛╨╝╨░╨╜╨┤╤Л. ╨Ю╨▒╤П╨╖╨░╤В╨╡╨╗╤М╨╜╨░, ╨╡╤Б╨╗╨╕ PR ╤Б ╤В╨░╨║╨╕╨╝ ╨╕╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А╨╛╨╝ ╨╡╤Б╤В╤М ╨▓ ╨╜╨╡╤Б╨║╨╛╨╗╤М╨║╨╕╤Е ╨║╨╛╨╝╨░╨╜╨┤╨░╤Е
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// PostPullRequestAcknowledgeJSONBody defines parameters for PostPullRequestAcknowledge.
+type PostPullRequestAcknowledgeJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+
+	// TeamName ╨Ъ╨╛╨╝╨░╨╜╨┤╨░ PR; ╨╡╤Б╨╗╨╕ ╤Г╨║╨░╨╖╨░╨╜╨░, PR ╨╕╤Й╨╡╤В╤Б╤П ╤В╨╛╨╗╤М╨║╨╛ ╤Б╤А╨╡╨┤╨╕ PR ╤Н╤В╨╛╨╣ ╨║╨╛╨╝╨░╨╜╨┤╤Л. ╨Ю╨▒╤П╨╖╨░╤В╨╡╨╗╤М╨╜╨░, ╨╡╤Б╨╗╨╕ PR ╤Б ╤В╨░╨║╨╕╨╝ ╨╕╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А╨╛╨╝ ╨╡╤Б╤В╤М ╨▓ ╨╜╨╡╤Б╨║╨╛╨╗╤М╨║╨╕╤Е ╨║╨╛╨╝╨░╨╜╨┤╨░╤Е
+	TeamName *string `json:"team_name,omitempty"`
+
+	UserId string `json:"user_id"`
+}
+
+// PostPullRequestApproveJSONBody defines parameters for PostPullRequestApprove.
+type PostPullRequestApproveJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+
+	// TeamName ╨Ъ╨╛╨╝╨░╨╜╨┤╨░ PR; ╨╡╤Б╨╗╨╕ ╤Г╨║╨░╨╖╨░╨╜╨░, PR ╨╕╤Й╨╡╤В╤Б╤П ╤В╨╛╨╗╤М╨║╨╛ ╤Б╤А╨╡╨┤╨╕ PR ╤Н╤В╨╛╨╣ ╨║╨╛╨╝╨░╨╜╨┤╤Л. ╨Ю╨▒╤П╨╖╨░╤В╨╡╨╗╤М╨╜╨░, ╨╡╤Б╨╗╨╕ PR ╤Б ╤В╨░╨║╨╕╨╝ ╨╕╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А╨╛╨╝ ╨╡╤Б╤В╤М ╨▓ ╨╜╨╡╤Б╨║╨╛╨╗╤М╨║╨╕╤Е ╨║╨╛╨╝╨░╨╜╨┤╨░╤Е
+	TeamName *string `json:"team_name,omitempty"`
+
+	UserId string `json:"user_id"`
+}
+
+// GetPullRequestAcknowledgementsParams defines parameters for GetPullRequestAcknowledgements.
+type GetPullRequestAcknowledgementsParams struct {
+	// PullRequestId ╨Ш╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+
+	// TeamName ╨Ъ╨╛╨╝╨░╨╜╨┤╨░ PR; ╨╛╨▒╤П╨╖╨░╤В╨╡╨╗╤М╨╜╨░, ╨╡╤Б╨╗╨╕ PR ╤Б ╤В╨░╨║╨╕╨╝ ╨╕╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А╨╛╨╝ ╨╡╤Б╤В╤М ╨▓ ╨╜╨╡╤Б╨║╨╛╨╗╤М╨║╨╕╤Е ╨║╨╛╨╝╨░╨╜╨┤╨░╤Е
+	TeamName *PRTeamNameQuery `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// GetPullRequestGetParams defines parameters for GetPullRequestGet.
+type GetPullRequestGetParams struct {
+	// PullRequestId ╨Ш╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+
+	// TeamName ╨Ъ╨╛╨╝╨░╨╜╨┤╨░ PR; ╨╛╨▒╤П╨╖╨░╤В╨╡╨╗╤М╨╜╨░, ╨╡╤Б╨╗╨╕ PR ╤Б ╤В╨░╨║╨╕╨╝ ╨╕╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А╨╛╨╝ ╨╡╤Б╤В╤М ╨▓ ╨╜╨╡╤Б╨║╨╛╨╗╤М╨║╨╕╤Е ╨║╨╛╨╝╨░╨╜╨┤╨░╤Е
+	TeamName *PRTeamNameQuery `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// Fields ╨б╨┐╨╕╤Б╨╛╨║ ╨┐╨╛╨╗╨╡╨╣ ╤З╨╡╤А╨╡╨╖ ╨╖╨░╨┐╤П╤В╤Г╤О, ╨║╨╛╤В╨╛╤А╤Л╨╡ ╨╜╤Г╨╢╨╜╨╛ ╨╛╤Б╤В╨░╨▓╨╕╤В╤М ╨▓ ╨║╨░╨╢╨┤╨╛╨╝ ╨╛╨▒╤К╨╡╨║╤В╨╡ ╨╛╤В╨▓╨╡╤В╨░
+	Fields *FieldsQuery `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Strict ╨Ю╤В╨▓╨╡╤З╨░╤В╤М 400 ╨╜╨░ ╨╜╨╡╨╕╨╖╨▓╨╡╤Б╤В╨╜╤Л╨╡ ╨╕╨╝╨╡╨╜╨░ ╨▓ fields ╨▓╨╝╨╡╤Б╤В╨╛ ╤В╨╛╨│╨╛, ╤З╤В╨╛╨▒╤Л ╨╕╤Е ╨╕╨│╨╜╨╛╤А╨╕╤А╨╛╨▓╨░╤В╤М
+	Strict *StrictQuery `form:"strict,omitempty" json:"strict,omitempty"`
+}
+
+// GetPullRequestHistoryParams defines parameters for GetPullRequestHistory.
+type GetPullRequestHistoryParams struct {
+	// PullRequestId ╨Ш╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+
+	// TeamName ╨Ъ╨╛╨╝╨░╨╜╨┤╨░ PR; ╨╛╨▒╤П╨╖╨░╤В╨╡╨╗╤М╨╜╨░, ╨╡╤Б╨╗╨╕ PR ╤Б ╤В╨░╨║╨╕╨╝ ╨╕╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А╨╛╨╝ ╨╡╤Б╤В╤М ╨▓ ╨╜╨╡╤Б╨║╨╛╨╗╤М╨║╨╕╤Е ╨║╨╛╨╝╨░╨╜╨┤╨░╤Е
+	TeamName *PRTeamNameQuery `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// Fields ╨б╨┐╨╕╤Б╨╛╨║ ╨┐╨╛╨╗╨╡╨╣ ╤З╨╡╤А╨╡╨╖ ╨╖╨░╨┐╤П╤В╤Г╤О, ╨║╨╛╤В╨╛╤А╤Л╨╡ ╨╜╤Г╨╢╨╜╨╛ ╨╛╤Б╤В╨░╨▓╨╕╤В╤М ╨▓ ╨║╨░╨╢╨┤╨╛╨╝ ╨╛╨▒╤К╨╡╨║╤В╨╡ ╨╛╤В╨▓╨╡╤В╨░
+	Fields *FieldsQuery `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Strict ╨Ю╤В╨▓╨╡╤З╨░╤В╤М 400 ╨╜╨░ ╨╜╨╡╨╕╨╖╨▓╨╡╤Б╤В╨╜╤Л╨╡ ╨╕╨╝╨╡╨╜╨░ ╨▓ fields ╨▓╨╝╨╡╤Б╤В╨╛ ╤В╨╛╨│╨╛, ╤З╤В╨╛╨▒╤Л ╨╕╤Е ╨╕╨│╨╜╨╛╤А╨╕╤А╨╛╨▓╨░╤В╤М
+	Strict *StrictQuery `form:"strict,omitempty" json:"strict,omitempty"`
+}
+
+// GetPullRequestWhyAssignedParams defines parameters for GetPullRequestWhyAssigned.
+type GetPullRequestWhyAssignedParams struct {
+	// PullRequestId ╨Ш╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+
+	// TeamName ╨Ъ╨╛╨╝╨░╨╜╨┤╨░ PR; ╨╛╨▒╤П╨╖╨░╤В╨╡╨╗╤М╨╜╨░, ╨╡╤Б╨╗╨╕ PR ╤Б ╤В╨░╨║╨╕╨╝ ╨╕╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А╨╛╨╝ ╨╡╤Б╤В╤М ╨▓ ╨╜╨╡╤Б╨║╨╛╨╗╤М╨║╨╕╤Е ╨║╨╛╨╝╨░╨╜╨┤╨░╤Е
+	TeamName *PRTeamNameQuery `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// PostPullRequestCreateParams defines parameters for PostPullRequestCreate.
+type PostPullRequestCreateParams struct {
+	// Expand reviewers тАФ ╨▓╨╡╤А╨╜╤Г╤В╤М ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╜╤Л╤Е ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ ╤Б ╨╕╤Е ╨╜╨░╨│╤А╤Г╨╖╨║╨╛╨╣ ╨╜╨░ ╨╝╨╛╨╝╨╡╨╜╤В ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╤П
+	Expand *string `form:"expand,omitempty" json:"expand,omitempty"`
+}
+
+// PostPullRequestCloseJSONBody defines parameters for PostPullRequestClose.
+type PostPullRequestCloseJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+
+	// TeamName ╨Ъ╨╛╨╝╨░╨╜╨┤╨░ PR; ╨╡╤Б╨╗╨╕ ╤Г╨║╨░╨╖╨░╨╜╨░, PR ╨╕╤Й╨╡╤В╤Б╤П ╤В╨╛╨╗╤М╨║╨╛ ╤Б╤А╨╡╨┤╨╕ PR ╤Н╤В╨╛╨╣ ╨║╨╛╨╝╨░╨╜╨┤╤Л. ╨Ю╨▒╤П╨╖╨░╤В╨╡╨╗╤М╨╜╨░, ╨╡╤Б╨╗╨╕ PR ╤Б ╤В╨░╨║╨╕╨╝ ╨╕╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А╨╛╨╝ ╨╡╤Б╤В╤М ╨▓ ╨╜╨╡╤Б╨║╨╛╨╗╤М╨║╨╕╤Е ╨║╨╛╨╝╨░╨╜╨┤╨░╤Е
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
+type PostPullRequestCreateJSONBody struct {
+	AuthorId string `json:"author_id"`
+
+	// Paths ╨Ш╨╖╨╝╨╡╨╜╤С╨╜╨╜╤Л╨╡ ╤Д╨░╨╣╨╗╤Л; ╨▓╨╗╨░╨┤╨╡╨╗╤М╤Ж╤Л ╤Н╤В╨╕╤Е ╨┐╤Г╤В╨╡╨╣ ╨╜╨░╨╖╨╜╨░╤З╨░╤О╤В╤Б╤П ╨▓ ╨┐╨╡╤А╨▓╤Г╤О ╨╛╤З╨╡╤А╨╡╨┤╤М
+	Paths           *[]string `json:"paths,omitempty"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+
+	// Priority ╨Я╤А╨╕ HIGH ╨╕ ╨▓╨║╨╗╤О╤З╤С╨╜╨╜╨╛╨╝ ╤Д╨╗╨░╨│╨╡ fast_responder_routing ╨┐╤А╨╡╨┤╨┐╨╛╤З╨╕╤В╨░╤О╤В╤Б╤П ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╤Л ╤Б ╨╜╨░╨╕╨╝╨╡╨╜╤М╤И╨╕╨╝ ╤Б╤А╨╡╨┤╨╜╨╕╨╝ ╨▓╤А╨╡╨╝╨╡╨╜╨╡╨╝ ╨╛╤В╨▓╨╡╤В╨░ ╨╖╨░ 30 ╨┤╨╜╨╡╨╣; ╨┐╤А╨╕ ╨╜╨╡╤Е╨▓╨░╤В╨║╨╡ ╨┤╨░╨╜╨╜╤Л╤Е ╨▓╤Л╨▒╨╕╤А╨░╤О╤В╤Б╤П ╨╜╨░╨╕╨╝╨╡╨╜╨╡╨╡ ╨╖╨░╨│╤А╤Г╨╢╨╡╨╜╨╜╤Л╨╡
+	Priority *PostPullRequestCreateJSONBodyPriority `json:"priority,omitempty"`
+
+	// RelatedPullRequestId PR, ╨┐╤А╨╛╨┤╨╛╨╗╨╢╨╡╨╜╨╕╨╡╨╝ ╨║╨╛╤В╨╛╤А╨╛╨│╨╛ ╤П╨▓╨╗╤П╨╡╤В╤Б╤П ╤Н╤В╨╛╤В; ╨╡╨│╨╛ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╤Л ╨╜╨░╨╖╨╜╨░╤З╨░╤О╤В╤Б╤П ╨▓ ╨┐╨╛╤Б╨╗╨╡╨┤╨╜╤О╤О ╨╛╤З╨╡╤А╨╡╨┤╤М
+	RelatedPullRequestId *string `json:"related_pull_request_id,omitempty"`
+
+	// RequiredSkills ╨Э╨░╨▓╤Л╨║╨╕, ╨║╨╛╤В╨╛╤А╤Л╨╡ ╨╜╤Г╨╢╨╜╤Л ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╤Г (╨╜╨░╨┐╤А╨╕╨╝╨╡╤А, ╤П╨╖╤Л╨║ ╨┤╨╛╨║╤Г╨╝╨╡╨╜╤В╨░╤Ж╨╕╨╕); ╨┐╤А╨╡╨┤╨┐╨╛╤З╨╕╤В╨░╤О╤В╤Б╤П ╨║╨░╨╜╨┤╨╕╨┤╨░╤В╤Л ╤Б╨╛ ╨▓╤Б╨╡╨╝╨╕ ╨╜╨░╨▓╤Л╨║╨░╨╝╨╕
+	RequiredSkills *[]string  `json:"required_skills,omitempty"`
+	ReviewDeadline *time.Time `json:"review_deadline,omitempty"`
+}
+
+// PostPullRequestCreateJSONBodyPriority defines parameters for PostPullRequestCreate.
+type PostPullRequestCreateJSONBodyPriority string
+
+// PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+
+	// TeamName ╨Ъ╨╛╨╝╨░╨╜╨┤╨░ PR; ╨╡╤Б╨╗╨╕ ╤Г╨║╨░╨╖╨░╨╜╨░, PR ╨╕╤Й╨╡╤В╤Б╤П ╤В╨╛╨╗╤М╨║╨╛ ╤Б╤А╨╡╨┤╨╕ PR ╤Н╤В╨╛╨╣ ╨║╨╛╨╝╨░╨╜╨┤╤Л. ╨Ю╨▒╤П╨╖╨░╤В╨╡╨╗╤М╨╜╨░, ╨╡╤Б╨╗╨╕ PR ╤Б ╤В╨░╨║╨╕╨╝ ╨╕╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А╨╛╨╝ ╨╡╤Б╤В╤М ╨▓ ╨╜╨╡╤Б╨║╨╛╨╗╤М╨║╨╕╤Е ╨║╨╛╨╝╨░╨╜╨┤╨░╤Е
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignJSONBody struct {
+	// NewUserId ╨Ъ╨╛╨╜╨║╤А╨╡╤В╨╜╤Л╨╣ ╨╜╨╛╨▓╤Л╨╣ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А; ╨▒╨╡╨╖ ╨╜╨╡╨│╨╛ ╨╖╨░╨╝╨╡╨╜╨░ ╨▓╤Л╨▒╨╕╤А╨░╨╡╤В╤Б╤П ╨░╨▓╤В╨╛╨╝╨░╤В╨╕╤З╨╡╤Б╨║╨╕
+	NewUserId     *string `json:"new_user_id,omitempty"`
+	OldUserId     string  `json:"old_user_id"`
+	PullRequestId string  `json:"pull_request_id"`
+
+	// TeamName ╨Ъ╨╛╨╝╨░╨╜╨┤╨░ PR; ╨╡╤Б╨╗╨╕ ╤Г╨║╨░╨╖╨░╨╜╨░, PR ╨╕╤Й╨╡╤В╤Б╤П ╤В╨╛╨╗╤М╨║╨╛ ╤Б╤А╨╡╨┤╨╕ PR ╤Н╤В╨╛╨╣ ╨║╨╛╨╝╨░╨╜╨┤╤Л. ╨Ю╨▒╤П╨╖╨░╤В╨╡╨╗╤М╨╜╨░, ╨╡╤Б╨╗╨╕ PR ╤Б ╤В╨░╨║╨╕╨╝ ╨╕╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А╨╛╨╝ ╨╡╤Б╤В╤М ╨▓ ╨╜╨╡╤Б╨║╨╛╨╗╤М╨║╨╕╤Е ╨║╨╛╨╝╨░╨╜╨┤╨░╤Е
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// GetTeamAssignmentTrendParams defines parameters for GetTeamAssignmentTrend.
+type GetTeamAssignmentTrendParams struct {
+	// TeamName ╨г╨╜╨╕╨║╨░╨╗╤М╨╜╨╛╨╡ ╨╕╨╝╤П ╨║╨╛╨╝╨░╨╜╨┤╤Л
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// Since ╨Э╨░╤З╨░╨╗╨╛ ╨┐╨╡╤А╨╕╨╛╨┤╨░ (╨┐╨╛ ╤Г╨╝╨╛╨╗╤З╨░╨╜╨╕╤О 30 ╨┤╨╜╨╡╨╣ ╨╜╨░╨╖╨░╨┤)
+	Since *SinceQuery `form:"since,omitempty" json:"since,omitempty"`
+
+	// Bucket ╨и╨░╨│ ╨│╤А╤Г╨┐╨┐╨╕╤А╨╛╨▓╨║╨╕ ╨▓╤А╨╡╨╝╨╡╨╜╨╜╨╛╨│╨╛ ╤А╤П╨┤╨░
+	Bucket *BucketQuery `form:"bucket,omitempty" json:"bucket,omitempty"`
+}
+
+// PostTeamBlackoutJSONBody defines parameters for PostTeamBlackout.
+type PostTeamBlackoutJSONBody struct {
+	EndsAt time.Time `json:"ends_at"`
+
+	// Recurrence none (╨┐╨╛ ╤Г╨╝╨╛╨╗╤З╨░╨╜╨╕╤О) ╨╕╨╗╨╕ weekly
+	Recurrence *string   `json:"recurrence,omitempty"`
+	StartsAt   time.Time `json:"starts_at"`
+	TeamName   string    `json:"team_name"`
+}
+
+// GetTeamCoverageGapsParams defines parameters for GetTeamCoverageGaps.
+type GetTeamCoverageGapsParams struct {
+	// TeamName ╨г╨╜╨╕╨║╨░╨╗╤М╨╜╨╛╨╡ ╨╕╨╝╤П ╨║╨╛╨╝╨░╨╜╨┤╤Л
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamCrossTeamReviewsParams defines parameters for GetTeamCrossTeamReviews.
+type GetTeamCrossTeamReviewsParams struct {
+	// TeamName ╨г╨╜╨╕╨║╨░╨╗╤М╨╜╨╛╨╡ ╨╕╨╝╤П ╨║╨╛╨╝╨░╨╜╨┤╤Л
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// Since ╨Э╨░╤З╨░╨╗╨╛ ╨┐╨╡╤А╨╕╨╛╨┤╨░ (╨┐╨╛ ╤Г╨╝╨╛╨╗╤З╨░╨╜╨╕╤О 30 ╨┤╨╜╨╡╨╣ ╨╜╨░╨╖╨░╨┤)
+	Since *SinceQuery `form:"since,omitempty" json:"since,omitempty"`
+}
+
+// GetTeamGetParams defines parameters for GetTeamGet.
+type GetTeamGetParams struct {
+	// TeamName ╨г╨╜╨╕╨║╨░╨╗╤М╨╜╨╛╨╡ ╨╕╨╝╤П ╨║╨╛╨╝╨░╨╜╨┤╤Л
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// Expand load тАФ ╨┤╨╛╨▒╨░╨▓╨╕╤В╤М ╨╜╨░╨│╤А╤Г╨╖╨║╤Г ╨╕ ╨┤╨╛╤Б╤В╤Г╨┐╨╜╨╛╤Б╤В╤М ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨╛╨▓
+	Expand *string `form:"expand,omitempty" json:"expand,omitempty"`
+
+	// Fields ╨б╨┐╨╕╤Б╨╛╨║ ╨┐╨╛╨╗╨╡╨╣ ╤З╨╡╤А╨╡╨╖ ╨╖╨░╨┐╤П╤В╤Г╤О, ╨║╨╛╤В╨╛╤А╤Л╨╡ ╨╜╤Г╨╢╨╜╨╛ ╨╛╤Б╤В╨░╨▓╨╕╤В╤М ╨▓ ╨║╨░╨╢╨┤╨╛╨╝ ╨╛╨▒╤К╨╡╨║╤В╨╡ ╨╛╤В╨▓╨╡╤В╨░
+	Fields *FieldsQuery `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Strict ╨Ю╤В╨▓╨╡╤З╨░╤В╤М 400 ╨╜╨░ ╨╜╨╡╨╕╨╖╨▓╨╡╤Б╤В╨╜╤Л╨╡ ╨╕╨╝╨╡╨╜╨░ ╨▓ fields ╨▓╨╝╨╡╤Б╤В╨╛ ╤В╨╛╨│╨╛, ╤З╤В╨╛╨▒╤Л ╨╕╤Е ╨╕╨│╨╜╨╛╤А╨╕╤А╨╛╨▓╨░╤В╤М
+	Strict *StrictQuery `form:"strict,omitempty" json:"strict,omitempty"`
+}
+
+// GetTeamLeaderboardParams defines parameters for GetTeamLeaderboard.
+type GetTeamLeaderboardParams struct {
+	// TeamName ╨г╨╜╨╕╨║╨░╨╗╤М╨╜╨╛╨╡ ╨╕╨╝╤П ╨║╨╛╨╝╨░╨╜╨┤╤Л
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// Since ╨Э╨░╤З╨░╨╗╨╛ ╨┐╨╡╤А╨╕╨╛╨┤╨░ (╨┐╨╛ ╤Г╨╝╨╛╨╗╤З╨░╨╜╨╕╤О 30 ╨┤╨╜╨╡╨╣ ╨╜╨░╨╖╨░╨┤)
+	Since *SinceQuery `form:"since,omitempty" json:"since,omitempty"`
+
+	// Limit ╨Ь╨░╨║╤Б╨╕╨╝╨░╨╗╤М╨╜╨╛╨╡ ╨║╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ ╨╖╨░╨┐╨╕╤Б╨╡╨╣ ╨▓ ╨╛╤В╨▓╨╡╤В╨╡
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset ╨Ъ╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ ╨┐╤А╨╛╨┐╤Г╤Б╨║╨░╨╡╨╝╤Л╤Е ╨╖╨░╨┐╨╕╤Б╨╡╨╣
+	Offset *OffsetQuery `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PatchTeamMemberJSONBody defines parameters for PatchTeamMember.
+type PatchTeamMemberJSONBody struct {
+	// IsActive ╨Я╨╛ ╤Г╨╝╨╛╨╗╤З╨░╨╜╨╕╤О true ╨┤╨╗╤П ╨╜╨╛╨▓╨╛╨│╨╛ ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨░
+	IsActive *bool  `json:"is_active,omitempty"`
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+
+	// Username ╨Ю╨▒╤П╨╖╨░╤В╨╡╨╗╨╡╨╜ ╨┐╤А╨╕ ╨┤╨╛╨▒╨░╨▓╨╗╨╡╨╜╨╕╨╕ ╨╜╨╛╨▓╨╛╨│╨╛ ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨░
+	Username *string `json:"username,omitempty"`
+}
+
+// PostTeamOwnershipJSONBody defines parameters for PostTeamOwnership.
+type PostTeamOwnershipJSONBody struct {
+	Rules    []OwnershipRule `json:"rules"`
+	TeamName string          `json:"team_name"`
+}
+
+// GetTeamPoolTrendParams defines parameters for GetTeamPoolTrend.
+type GetTeamPoolTrendParams struct {
+	// TeamName ╨г╨╜╨╕╨║╨░╨╗╤М╨╜╨╛╨╡ ╨╕╨╝╤П ╨║╨╛╨╝╨░╨╜╨┤╤Л
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// Since ╨Э╨░╤З╨░╨╗╨╛ ╨┐╨╡╤А╨╕╨╛╨┤╨░ (╨┐╨╛ ╤Г╨╝╨╛╨╗╤З╨░╨╜╨╕╤О 30 ╨┤╨╜╨╡╨╣ ╨╜╨░╨╖╨░╨┤)
+	Since *SinceQuery `form:"since,omitempty" json:"since,omitempty"`
+
+	// Bucket ╨и╨░╨│ ╨│╤А╤Г╨┐╨┐╨╕╤А╨╛╨▓╨║╨╕ ╨▓╤А╨╡╨╝╨╡╨╜╨╜╨╛╨│╨╛ ╤А╤П╨┤╨░
+	Bucket *BucketQuery `form:"bucket,omitempty" json:"bucket,omitempty"`
+}
+
+// PostTeamQuotaJSONBody defines parameters for PostTeamQuota.
+type PostTeamQuotaJSONBody struct {
+	// DefaultWeeklyQuota ╨Ь╨░╨║╤Б╨╕╨╝╤Г╨╝ ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╨╣ ╨╜╨░ ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤П ╨╖╨░ ISO-╨╜╨╡╨┤╨╡╨╗╤О
+	DefaultWeeklyQuota *int   `json:"default_weekly_quota"`
+	TeamName           string `json:"team_name"`
+}
+
+// PostTeamRebalanceParams defines parameters for PostTeamRebalance.
+type PostTeamRebalanceParams struct {
+	// TeamName ╨г╨╜╨╕╨║╨░╨╗╤М╨╜╨╛╨╡ ╨╕╨╝╤П ╨║╨╛╨╝╨░╨╜╨┤╤Л
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// MaxDeviation ╨Ф╨╛╨┐╤Г╤Б╤В╨╕╨╝╨░╤П ╤А╨░╨╖╨╜╨╕╤Ж╨░ ╨▓ ╨║╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╡ ╨╛╤В╨║╤А╤Л╤В╤Л╤Е ╤А╨╡╨▓╤М╤О
+	MaxDeviation *int `form:"max_deviation,omitempty" json:"max_deviation,omitempty"`
+}
+
+// PostTeamSettingsPreviewJSONBody defines parameters for PostTeamSettingsPreview.
+type PostTeamSettingsPreviewJSONBody struct {
+	// RequiredReviewers ╨в╤А╨╡╨▒╤Г╨╡╨╝╨╛╨╡ ╨║╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ (╨┐╨╛ ╤Г╨╝╨╛╨╗╤З╨░╨╜╨╕╤О 2)
+	RequiredReviewers *int `json:"required_reviewers,omitempty"`
+
+	// Strategy RANDOM, WEIGHTED ╨╕╨╗╨╕ LEAST_LOADED (╨┐╨╛ ╤Г╨╝╨╛╨╗╤З╨░╨╜╨╕╤О ╤В╨╡╨║╤Г╤Й╨░╤П ╤Б╤В╤А╨░╤В╨╡╨│╨╕╤П ╤Б╨╡╤А╨▓╨╕╤Б╨░)
+	Strategy *string `json:"strategy,omitempty"`
+	TeamName string  `json:"team_name"`
+}
+
+// GetTeamSlaParams defines parameters for GetTeamSla.
+type GetTeamSlaParams struct {
+	// TeamName ╨г╨╜╨╕╨║╨░╨╗╤М╨╜╨╛╨╡ ╨╕╨╝╤П ╨║╨╛╨╝╨░╨╜╨┤╤Л
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// Since ╨Э╨░╤З╨░╨╗╨╛ ╨┐╨╡╤А╨╕╨╛╨┤╨░ (╨┐╨╛ ╤Г╨╝╨╛╨╗╤З╨░╨╜╨╕╤О 30 ╨┤╨╜╨╡╨╣ ╨╜╨░╨╖╨░╨┤)
+	Since *SinceQuery `form:"since,omitempty" json:"since,omitempty"`
+}
+
+// PostTeamSnapshotDiffJSONBody defines parameters for PostTeamSnapshotDiff.
+type PostTeamSnapshotDiffJSONBody struct {
+	After  TeamSnapshot `json:"after"`
+	Before TeamSnapshot `json:"before"`
+}
+
+// GetTeamStatsParams defines parameters for GetTeamStats.
+type GetTeamStatsParams struct {
+	// TeamName ╨г╨╜╨╕╨║╨░╨╗╤М╨╜╨╛╨╡ ╨╕╨╝╤П ╨║╨╛╨╝╨░╨╜╨┤╤Л
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostUsersApiKeyJSONBody defines parameters for PostUsersApiKey.
+type PostUsersApiKeyJSONBody struct {
+	UserId string `json:"user_id"`
+}
+
+// PostUsersApiKeyRevokeJSONBody defines parameters for PostUsersApiKeyRevoke.
+type PostUsersApiKeyRevokeJSONBody struct {
+	UserId string `json:"user_id"`
+}
+
+// GetUsersAssignmentsParams defines parameters for GetUsersAssignments.
+type GetUsersAssignmentsParams struct {
+	// UserId ╨Ш╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤П
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// Since ╨Э╨░╤З╨░╨╗╨╛ ╨┐╨╡╤А╨╕╨╛╨┤╨░ (╨┐╨╛ ╤Г╨╝╨╛╨╗╤З╨░╨╜╨╕╤О 30 ╨┤╨╜╨╡╨╣ ╨╜╨░╨╖╨░╨┤)
+	Since *SinceQuery `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until ╨Ъ╨╛╨╜╨╡╤Ж ╨┐╨╡╤А╨╕╨╛╨┤╨░ (╨┐╨╛ ╤Г╨╝╨╛╨╗╤З╨░╨╜╨╕╤О ╤В╨╡╨║╤Г╤Й╨╕╨╣ ╨╝╨╛╨╝╨╡╨╜╤В)
+	Until *UntilQuery `form:"until,omitempty" json:"until,omitempty"`
+
+	// Limit ╨Ь╨░╨║╤Б╨╕╨╝╨░╨╗╤М╨╜╨╛╨╡ ╨║╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ ╨╖╨░╨┐╨╕╤Б╨╡╨╣ ╨▓ ╨╛╤В╨▓╨╡╤В╨╡
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset ╨Ъ╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ ╨┐╤А╨╛╨┐╤Г╤Б╨║╨░╨╡╨╝╤Л╤Е ╨╖╨░╨┐╨╕╤Б╨╡╨╣
+	Offset *OffsetQuery `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Fields ╨б╨┐╨╕╤Б╨╛╨║ ╨┐╨╛╨╗╨╡╨╣ ╤З╨╡╤А╨╡╨╖ ╨╖╨░╨┐╤П╤В╤Г╤О, ╨║╨╛╤В╨╛╤А╤Л╨╡ ╨╜╤Г╨╢╨╜╨╛ ╨╛╤Б╤В╨░╨▓╨╕╤В╤М ╨▓ ╨║╨░╨╢╨┤╨╛╨╝ ╨╛╨▒╤К╨╡╨║╤В╨╡ ╨╛╤В╨▓╨╡╤В╨░
+	Fields *FieldsQuery `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Strict ╨Ю╤В╨▓╨╡╤З╨░╤В╤М 400 ╨╜╨░ ╨╜╨╡╨╕╨╖╨▓╨╡╤Б╤В╨╜╤Л╨╡ ╨╕╨╝╨╡╨╜╨░ ╨▓ fields ╨▓╨╝╨╡╤Б╤В╨╛ ╤В╨╛╨│╨╛, ╤З╤В╨╛╨▒╤Л ╨╕╤Е ╨╕╨│╨╜╨╛╤А╨╕╤А╨╛╨▓╨░╤В╤М
+	Strict *StrictQuery `form:"strict,omitempty" json:"strict,omitempty"`
+}
+
+// PostUsersBoostJSONBody defines parameters for PostUsersBoost.
+type PostUsersBoostJSONBody struct {
+	// BoostFactor ╨Ь╨╜╨╛╨╢╨╕╤В╨╡╨╗╤М ╨▓╨╡╤Б╨░, ╨▒╨╛╨╗╤М╤И╨╡ 1
+	BoostFactor float64   `json:"boost_factor"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	UserId      string    `json:"user_id"`
+}
+
+// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParams struct {
+	// UserId ╨Ш╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤П
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// Status ╨Т╨╡╤А╨╜╤Г╤В╤М ╤В╨╛╨╗╤М╨║╨╛ PR ╨▓ ╤Н╤В╨╛╨╝ ╤Б╤В╨░╤В╤Г╤Б╨╡
+	Status *GetUsersGetReviewParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit ╨Ь╨░╨║╤Б╨╕╨╝╨░╨╗╤М╨╜╨╛╨╡ ╨║╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ ╨╖╨░╨┐╨╕╤Б╨╡╨╣ ╨▓ ╨╛╤В╨▓╨╡╤В╨╡
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset ╨Ъ╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛ ╨┐╤А╨╛╨┐╤Г╤Б╨║╨░╨╡╨╝╤Л╤Е ╨╖╨░╨┐╨╕╤Б╨╡╨╣
+	Offset *OffsetQuery `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Fields ╨б╨┐╨╕╤Б╨╛╨║ ╨┐╨╛╨╗╨╡╨╣ ╤З╨╡╤А╨╡╨╖ ╨╖╨░╨┐╤П╤В╤Г╤О, ╨║╨╛╤В╨╛╤А╤Л╨╡ ╨╜╤Г╨╢╨╜╨╛ ╨╛╤Б╤В╨░╨▓╨╕╤В╤М ╨▓ ╨║╨░╨╢╨┤╨╛╨╝ ╨╛╨▒╤К╨╡╨║╤В╨╡ ╨╛╤В╨▓╨╡╤В╨░
+	Fields *FieldsQuery `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Strict ╨Ю╤В╨▓╨╡╤З╨░╤В╤М 400 ╨╜╨░ ╨╜╨╡╨╕╨╖╨▓╨╡╤Б╤В╨╜╤Л╨╡ ╨╕╨╝╨╡╨╜╨░ ╨▓ fields ╨▓╨╝╨╡╤Б╤В╨╛ ╤В╨╛╨│╨╛, ╤З╤В╨╛╨▒╤Л ╨╕╤Е ╨╕╨│╨╜╨╛╤А╨╕╤А╨╛╨▓╨░╤В╤М
+	Strict *StrictQuery `form:"strict,omitempty" json:"strict,omitempty"`
+}
+
+// GetUsersGetReviewParamsStatus defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParamsStatus string
+
+// GetUsersPeakLoadParams defines parameters for GetUsersPeakLoad.
+type GetUsersPeakLoadParams struct {
+	// UserId ╨Ш╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤П
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// Since ╨Э╨░╤З╨░╨╗╨╛ ╨┐╨╡╤А╨╕╨╛╨┤╨░ (╨┐╨╛ ╤Г╨╝╨╛╨╗╤З╨░╨╜╨╕╤О 30 ╨┤╨╜╨╡╨╣ ╨╜╨░╨╖╨░╨┤)
+	Since *SinceQuery `form:"since,omitempty" json:"since,omitempty"`
+}
+
+// PostUsersQuotaJSONBody defines parameters for PostUsersQuota.
+type PostUsersQuotaJSONBody struct {
+	UserId string `json:"user_id"`
+
+	// WeeklyQuota ╨Ь╨░╨║╤Б╨╕╨╝╤Г╨╝ ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╨╣ ╨╖╨░ ISO-╨╜╨╡╨┤╨╡╨╗╤О
+	WeeklyQuota *int `json:"weekly_quota"`
+}
+
+// GetUsersReassignImpactParams defines parameters for GetUsersReassignImpact.
+type GetUsersReassignImpactParams struct {
+	// UserId ╨Ш╨┤╨╡╨╜╤В╨╕╤Д╨╕╨║╨░╤В╨╛╤А ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤П
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
+type PostUsersSetIsActiveJSONBody struct {
+	IsActive bool `json:"is_active"`
+
+	// ReassignOpenReviews ╨Я╨╡╤А╨╡╨╜╨░╨╖╨╜╨░╤З╨╕╤В╤М OPEN PR ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤П ╨╜╨░ ╨┤╤А╤Г╨│╨╕╤Е ╨░╨║╤В╨╕╨▓╨╜╤Л╤Е ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨╛╨▓ (╤В╨╛╨╗╤М╨║╨╛ ╨┐╤А╨╕ is_active=false)
+	ReassignOpenReviews *bool  `json:"reassign_open_reviews,omitempty"`
+	UserId              string `json:"user_id"`
+}
+
+// PostUsersSkillsJSONBody defines parameters for PostUsersSkills.
+type PostUsersSkillsJSONBody struct {
+	Skills []string `json:"skills"`
+	UserId string   `json:"user_id"`
+}
+
+// PatchPullRequestJSONRequestBody defines body for PatchPullRequest for application/json ContentType.
+type PatchPullRequestJSONRequestBody PatchPullRequestJSONBody
+
+// PatchTeamMemberJSONRequestBody defines body for PatchTeamMember for application/json ContentType.
+type PatchTeamMemberJSONRequestBody PatchTeamMemberJSONBody
+
+// PostAdminAssignmentQueueRetryJSONRequestBody defines body for PostAdminAssignmentQueueRetry for application/json ContentType.
+type PostAdminAssignmentQueueRetryJSONRequestBody PostAdminAssignmentQueueRetryJSONBody
+
+// PostAdminWebhooksJSONRequestBody defines body for PostAdminWebhooks for application/json ContentType.
+type PostAdminWebhooksJSONRequestBody PostAdminWebhooksJSONBody
+
+// PostAdminWebhooksDeleteJSONRequestBody defines body for PostAdminWebhooksDelete for application/json ContentType.
+type PostAdminWebhooksDeleteJSONRequestBody PostAdminWebhooksDeleteJSONBody
+
+// PostPullRequestAcknowledgeJSONRequestBody defines body for PostPullRequestAcknowledge for application/json ContentType.
+type PostPullRequestAcknowledgeJSONRequestBody PostPullRequestAcknowledgeJSONBody
+
+// PostPullRequestApproveJSONRequestBody defines body for PostPullRequestApprove for application/json ContentType.
+type PostPullRequestApproveJSONRequestBody PostPullRequestApproveJSONBody
+
+// PostPullRequestCloseJSONRequestBody defines body for PostPullRequestClose for application/json ContentType.
+type PostPullRequestCloseJSONRequestBody PostPullRequestCloseJSONBody
+
+// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
+type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
+
+// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
+type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
+
+// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
+type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+
+// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
+type PostTeamAddJSONRequestBody = Team
+
+// PostTeamBlackoutJSONRequestBody defines body for PostTeamBlackout for application/json ContentType.
+type PostTeamBlackoutJSONRequestBody PostTeamBlackoutJSONBody
+
+// PostTeamOwnershipJSONRequestBody defines body for PostTeamOwnership for application/json ContentType.
+type PostTeamOwnershipJSONRequestBody PostTeamOwnershipJSONBody
+
+// PostTeamQuotaJSONRequestBody defines body for PostTeamQuota for application/json ContentType.
+type PostTeamQuotaJSONRequestBody PostTeamQuotaJSONBody
+
+// PostTeamSettingsPreviewJSONRequestBody defines body for PostTeamSettingsPreview for application/json ContentType.
+type PostTeamSettingsPreviewJSONRequestBody PostTeamSettingsPreviewJSONBody
+
+// PostTeamSnapshotDiffJSONRequestBody defines body for PostTeamSnapshotDiff for application/json ContentType.
+type PostTeamSnapshotDiffJSONRequestBody PostTeamSnapshotDiffJSONBody
+
+// PostUsersApiKeyJSONRequestBody defines body for PostUsersApiKey for application/json ContentType.
+type PostUsersApiKeyJSONRequestBody PostUsersApiKeyJSONBody
+
+// PostUsersApiKeyRevokeJSONRequestBody defines body for PostUsersApiKeyRevoke for application/json ContentType.
+type PostUsersApiKeyRevokeJSONRequestBody PostUsersApiKeyRevokeJSONBody
+
+// PostUsersBoostJSONRequestBody defines body for PostUsersBoost for application/json ContentType.
+type PostUsersBoostJSONRequestBody PostUsersBoostJSONBody
+
+// PostUsersQuotaJSONRequestBody defines body for PostUsersQuota for application/json ContentType.
+type PostUsersQuotaJSONRequestBody PostUsersQuotaJSONBody
+
+// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
+type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+
+// PostUsersSkillsJSONRequestBody defines body for PostUsersSkills for application/json ContentType.
+type PostUsersSkillsJSONRequestBody PostUsersSkillsJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М PR, ╨╛╨╢╨╕╨┤╨░╤О╤Й╨╕╨╡ ╨┐╨╛╨▓╤В╨╛╤А╨╜╨╛╨╣ ╨┐╨╛╨┐╤Л╤В╨║╨╕ ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╤П ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓
+	// (GET /admin/assignment-queue)
+	GetAdminAssignmentQueue(ctx echo.Context) error
+	// ╨Э╨╡╨╝╨╡╨┤╨╗╨╡╨╜╨╜╨╛ ╨┐╨╛╨▓╤В╨╛╤А╨╕╤В╤М ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╨╡ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ ╨┤╨╗╤П PR ╨╕╨╖ ╨╛╤З╨╡╤А╨╡╨┤╨╕
+	// (POST /admin/assignment-queue/retry)
+	PostAdminAssignmentQueueRetry(ctx echo.Context) error
+	// ╨б╨╛╨╖╨┤╨░╤В╤М ╨╜╨╡╨░╨║╤В╨╕╨▓╨╜╤Л╤Е ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╨╡╨╣-╨╖╨░╨│╨╗╤Г╤И╨╡╨║ ╨┤╨╗╤П ╨░╨▓╤В╨╛╤А╨╛╨▓ PR ╨▒╨╡╨╖ ╨╖╨░╨┐╨╕╤Б╨╕ ╨▓ users
+	// (POST /admin/backfill-membership)
+	PostAdminBackfillMembership(ctx echo.Context) error
+	// ╨Ю╤Ж╨╡╨╜╨╕╤В╤М ╤А╨░╨▓╨╜╨╛╨╝╨╡╤А╨╜╨╛╤Б╤В╤М ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╨╣ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ ╨┐╨╛ ╨▓╤Б╨╡╨╣ ╨╛╤А╨│╨░╨╜╨╕╨╖╨░╤Ж╨╕╨╕
+	// (GET /admin/fairness)
+	GetAdminFairness(ctx echo.Context, params GetAdminFairnessParams) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М ╤В╨╡╨║╤Г╤Й╨╕╨╡ ╨╖╨╜╨░╤З╨╡╨╜╨╕╤П feature-╤Д╨╗╨░╨│╨╛╨▓
+	// (GET /admin/flags)
+	GetAdminFlags(ctx echo.Context) error
+	// ╨Э╨░╨╣╤В╨╕ PR, ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╤Л ╨║╨╛╤В╨╛╤А╤Л╤Е ╨┐╨╡╤А╨╡╨╜╨░╨╖╨╜╨░╤З╨░╨╗╨╕╤Б╤М ╤Б╨╗╨╕╤И╨║╨╛╨╝ ╤З╨░╤Б╤В╨╛
+	// (GET /admin/high-churn-prs)
+	GetAdminHighChurnPrs(ctx echo.Context, params GetAdminHighChurnPrsParams) error
+	// ╨Э╨░╨╣╤В╨╕ ╨║╨╛╨╝╨░╨╜╨┤╤Л ╤Б ╨╜╨╡╤А╨░╨▓╨╜╨╛╨╝╨╡╤А╨╜╤Л╨╝ ╤А╨░╤Б╨┐╤А╨╡╨┤╨╡╨╗╨╡╨╜╨╕╨╡╨╝ ╤А╨╡╨▓╤М╤О
+	// (GET /admin/imbalance-alerts)
+	GetAdminImbalanceAlerts(ctx echo.Context, params GetAdminImbalanceAlertsParams) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М OPEN PR, ╤Б╤А╨╡╨┤╨╕ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ ╨║╨╛╤В╨╛╤А╤Л╤Е ╨╡╤Б╤В╤М ╨╜╨╡╨░╨║╤В╨╕╨▓╨╜╤Л╨╡ ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╨╕
+	// (GET /admin/inactive-assignments)
+	GetAdminInactiveAssignments(ctx echo.Context) error
+	// ╨Э╨░╨╣╤В╨╕ ╨╕ ╨╕╤Б╨┐╤А╨░╨▓╨╕╤В╤М PR ╤Б ╨╜╨╡╤Б╨╛╨│╨╗╨░╤Б╨╛╨▓╨░╨╜╨╜╤Л╨╝╨╕ status ╨╕ mergedAt
+	// (POST /admin/integrity/pr-status)
+	PostAdminIntegrityPrStatus(ctx echo.Context, params PostAdminIntegrityPrStatusParams) error
+	// ╨Э╨░╨╣╤В╨╕ PR, ╨│╨┤╨╡ ╨░╨▓╤В╨╛╤А ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜ ╤Б╨╛╨▒╤Б╤В╨▓╨╡╨╜╨╜╤Л╨╝ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨╝
+	// (GET /admin/integrity/self-review)
+	GetAdminIntegritySelfReview(ctx echo.Context) error
+	// ╨г╨▒╤А╨░╤В╤М ╨░╨▓╤В╨╛╤А╨╛╨▓ ╨╕╨╖ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ ╤Б╨╛╨▒╤Б╤В╨▓╨╡╨╜╨╜╤Л╤Е PR
+	// (POST /admin/integrity/self-review)
+	PostAdminIntegritySelfReview(ctx echo.Context) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М OPEN PR, ╨┤╨╛╨╗╤М╤И╨╡ ╨▓╤Б╨╡╤Е ╨╛╨╢╨╕╨┤╨░╤О╤Й╨╕╨╡ ╤А╨╡╨▓╤М╤О
+	// (GET /admin/oldest-pending)
+	GetAdminOldestPending(ctx echo.Context, params GetAdminOldestPendingParams) error
+	// ╨Т╤Л╨│╤А╤Г╨╖╨╕╤В╤М ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╤П ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ ╨╖╨░ ╨┐╨╡╤А╨╕╨╛╨┤ ╨┤╨╗╤П ╨░╤Г╨┤╨╕╤В╨░
+	// (GET /admin/review-export)
+	GetAdminReviewExport(ctx echo.Context, params GetAdminReviewExportParams) error
+	// ╨Т╤Л╨│╤А╤Г╨╖╨╕╤В╤М ╨╝╨░╤В╤А╨╕╤Ж╤Г ╤А╨╡╨▓╤М╤О╨╡╤А ├Ч ╨░╨▓╤В╨╛╤А ╨┐╨╛ ╨▓╤Б╨╡╨╣ ╨╛╤А╨│╨░╨╜╨╕╨╖╨░╤Ж╨╕╨╕
+	// (GET /admin/review-matrix/stream)
+	GetAdminReviewMatrixStream(ctx echo.Context) error
+	// ╨б╤А╨░╨▓╨╜╨╕╤В╤М ╤А╨╡╨╖╤Г╨╗╤М╤В╨░╤В╤Л ╤Б╤В╤А╨░╤В╨╡╨│╨╕╨╣ ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╤П ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓
+	// (GET /admin/strategy-outcomes)
+	GetAdminStrategyOutcomes(ctx echo.Context, params GetAdminStrategyOutcomesParams) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М ╨║╨╛╨╝╨░╨╜╨┤╤Л ╤Б ╨║╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╨╛╨╝ ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨╛╨▓ ╨▓ ╨╖╨░╨┤╨░╨╜╨╜╨╛╨╝ ╨┤╨╕╨░╨┐╨░╨╖╨╛╨╜╨╡
+	// (GET /admin/teams)
+	GetAdminTeams(ctx echo.Context, params GetAdminTeamsParams) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М ╨┐╨╛╨┤╨┐╨╕╤Б╨║╨╕ ╨╜╨░ ╤Б╨╝╨╡╨╜╤Г ╤Б╤В╨░╤В╤Г╤Б╨░ PR
+	// (GET /admin/webhooks)
+	GetAdminWebhooks(ctx echo.Context) error
+	// ╨Я╨╛╨┤╨┐╨╕╤Б╨░╤В╤М╤Б╤П ╨╜╨░ ╤Б╨╝╨╡╨╜╤Г ╤Б╤В╨░╤В╤Г╤Б╨░ PR
+	// (POST /admin/webhooks)
+	PostAdminWebhooks(ctx echo.Context) error
+	// ╨г╨┤╨░╨╗╨╕╤В╤М ╨┐╨╛╨┤╨┐╨╕╤Б╨║╤Г ╨╜╨░ ╤Б╨╝╨╡╨╜╤Г ╤Б╤В╨░╤В╤Г╤Б╨░ PR
+	// (POST /admin/webhooks/delete)
+	PostAdminWebhooksDelete(ctx echo.Context) error
+	// ╨Ю╤В╨┐╤А╨░╨▓╨╕╤В╤М ╤В╨╡╤Б╤В╨╛╨▓╨╛╨╡ ╤Б╨╛╨▒╤Л╤В╨╕╨╡ ╨┐╨╛╨┤╨┐╨╕╤Б╤З╨╕╨║╤Г
+	// (POST /admin/webhooks/{id}/test)
+	PostAdminWebhooksIdTest(ctx echo.Context, id int64) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М ╤Б╨┐╨╕╤Б╨╛╨║ ╨▓╤Б╨╡╤Е ╤Н╨╜╨┤╨┐╨╛╨╕╨╜╤В╨╛╨▓ ╨╕ ╤В╤А╨╡╨▒╤Г╨╡╨╝╤Л╤Е ╨┤╨╗╤П ╨╜╨╕╤Е ╨┐╤А╨░╨▓
+	// (GET /meta/endpoints)
+	GetMetaEndpoints(ctx echo.Context) error
+	// ╨Ш╨╖╨╝╨╡╨╜╨╕╤В╤М ╨╜╨░╨╖╨▓╨░╨╜╨╕╨╡ PR
+	// (PATCH /pull-request)
+	PatchPullRequest(ctx echo.Context) error
+	// ╨Ю╤В╨╝╨╡╤В╨╕╤В╤М, ╤З╤В╨╛ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А ╤Г╨▓╨╕╨┤╨╡╨╗ ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╨╡ ╨╜╨░ PR
+	// (POST /pull-request/acknowledge)
+	PostPullRequestAcknowledge(ctx echo.Context) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М ╨╛╤В╨╝╨╡╤В╨║╨╕ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ ╨╛ ╤В╨╛╨╝, ╤З╤В╨╛ ╨╛╨╜╨╕ ╤Г╨▓╨╕╨┤╨╡╨╗╨╕ PR
+	// (GET /pull-request/acknowledgements)
+	GetPullRequestAcknowledgements(ctx echo.Context, params GetPullRequestAcknowledgementsParams) error
+	// ╨Ю╨┤╨╛╨▒╤А╨╕╤В╤М PR ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╜╤Л╨╝ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨╝
+	// (POST /pull-request/approve)
+	PostPullRequestApprove(ctx echo.Context) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М PR ╤Б ╤В╨╡╨║╤Г╤Й╨╕╨╝╨╕ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨░╨╝╨╕
+	// (GET /pull-request/get)
+	GetPullRequestGet(ctx echo.Context, params GetPullRequestGetParams) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М ╨╕╤Б╤В╨╛╤А╨╕╤О ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╨╣ ╨╕ ╨┐╨╡╤А╨╡╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╨╣ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ PR
+	// (GET /pull-request/history)
+	GetPullRequestHistory(ctx echo.Context, params GetPullRequestHistoryParams) error
+	// ╨Ю╨▒╤К╤П╤Б╨╜╨╕╤В╤М, ╨┐╨╛╤З╨╡╨╝╤Г PR ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╤Л ╤В╨╡╨║╤Г╤Й╨╕╨╡ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╤Л
+	// (GET /pull-request/why-assigned)
+	GetPullRequestWhyAssigned(ctx echo.Context, params GetPullRequestWhyAssignedParams) error
+	// ╨Ч╨░╨║╤А╤Л╤В╤М PR ╨▒╨╡╨╖ ╨╝╨╡╤А╨╢╨░ (╨╕╨┤╨╡╨╝╨┐╨╛╤В╨╡╨╜╤В╨╜╨░╤П ╨╛╨┐╨╡╤А╨░╤Ж╨╕╤П)
+	// (POST /pullRequest/close)
+	PostPullRequestClose(ctx echo.Context) error
+	// ╨б╨╛╨╖╨┤╨░╤В╤М PR ╨╕ ╨░╨▓╤В╨╛╨╝╨░╤В╨╕╤З╨╡╤Б╨║╨╕ ╨╜╨░╨╖╨╜╨░╤З╨╕╤В╤М ╨┤╨╛ 2 ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ ╨╕╨╖ ╨║╨╛╨╝╨░╨╜╨┤╤Л ╨░╨▓╤В╨╛╤А╨░
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(ctx echo.Context, params PostPullRequestCreateParams) error
+	// ╨Я╨╛╨╝╨╡╤В╨╕╤В╤М PR ╨║╨░╨║ MERGED (╨╕╨┤╨╡╨╝╨┐╨╛╤В╨╡╨╜╤В╨╜╨░╤П ╨╛╨┐╨╡╤А╨░╤Ж╨╕╤П)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(ctx echo.Context) error
+	// ╨Я╨╡╤А╨╡╨╜╨░╨╖╨╜╨░╤З╨╕╤В╤М ╨║╨╛╨╜╨║╤А╨╡╤В╨╜╨╛╨│╨╛ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨░ ╨╜╨░ ╨┤╤А╤Г╨│╨╛╨│╨╛ ╨╕╨╖ ╨╡╨│╨╛ ╨║╨╛╨╝╨░╨╜╨┤╤Л
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(ctx echo.Context) error
+	// ╨б╨╛╨╖╨┤╨░╤В╤М ╨║╨╛╨╝╨░╨╜╨┤╤Г ╤Б ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨░╨╝╨╕ ╨╕╨╗╨╕ ╨┤╨╛╨┐╨╛╨╗╨╜╨╕╤В╤М ╤Б╤Г╤Й╨╡╤Б╤В╨▓╤Г╤О╤Й╤Г╤О (╤Б╨╛╨╖╨┤╨░╤С╤В/╨╛╨▒╨╜╨╛╨▓╨╗╤П╨╡╤В ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╨╡╨╣)
+	// (POST /team/add)
+	PostTeamAdd(ctx echo.Context) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М ╨┤╨╕╨╜╨░╨╝╨╕╨║╤Г ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╨╣ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ ╨║╨╛╨╝╨░╨╜╨┤╤Л ╨┐╨╛ ╨┤╨╜╤П╨╝ ╨╕╨╗╨╕ ╨╜╨╡╨┤╨╡╨╗╤П╨╝
+	// (GET /team/assignment-trend)
+	GetTeamAssignmentTrend(ctx echo.Context, params GetTeamAssignmentTrendParams) error
+	// ╨Ф╨╛╨▒╨░╨▓╨╕╤В╤М ╨┐╨╡╤А╨╕╨╛╨┤ ╨╖╨░╨╝╨╛╤А╨╛╨╖╨║╨╕ ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╨╣ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ ╨┤╨╗╤П ╨║╨╛╨╝╨░╨╜╨┤╤Л
+	// (POST /team/blackout)
+	PostTeamBlackout(ctx echo.Context) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М OPEN PR ╨║╨╛╨╝╨░╨╜╨┤╤Л, ╨║╨╛╤В╨╛╤А╤Л╨╝ ╨╜╨╡ ╤Е╨▓╨░╤В╨░╨╡╤В ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓
+	// (GET /team/coverage-gaps)
+	GetTeamCoverageGaps(ctx echo.Context, params GetTeamCoverageGapsParams) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М, ╤Б╨║╨╛╨╗╤М╨║╨╛ ╤А╨╡╨▓╤М╤О ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨╕ ╨║╨╛╨╝╨░╨╜╨┤╤Л ╤Б╨┤╨╡╨╗╨░╨╗╨╕ ╨┤╨╗╤П ╨┤╤А╤Г╨│╨╕╤Е ╨║╨╛╨╝╨░╨╜╨┤
+	// (GET /team/cross-team-reviews)
+	GetTeamCrossTeamReviews(ctx echo.Context, params GetTeamCrossTeamReviewsParams) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М ╨║╨╛╨╝╨░╨╜╨┤╤Г ╤Б ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨░╨╝╨╕
+	// (GET /team/get)
+	GetTeamGet(ctx echo.Context, params GetTeamGetParams) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М ╤А╨╡╨╣╤В╨╕╨╜╨│ ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨╛╨▓ ╨║╨╛╨╝╨░╨╜╨┤╤Л ╨┐╨╛ ╨║╨╛╨╗╨╕╤З╨╡╤Б╤В╨▓╤Г ╨┐╤А╨╛╨▓╨╡╨┤╤С╨╜╨╜╤Л╤Е ╤А╨╡╨▓╤М╤О
+	// (GET /team/leaderboard)
+	GetTeamLeaderboard(ctx echo.Context, params GetTeamLeaderboardParams) error
+	// ╨Ф╨╛╨▒╨░╨▓╨╕╤В╤М ╨╕╨╗╨╕ ╨╕╨╖╨╝╨╡╨╜╨╕╤В╤М ╨╛╨┤╨╜╨╛╨│╨╛ ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨░ ╨║╨╛╨╝╨░╨╜╨┤╤Л, ╨╜╨╡ ╨╖╨░╤В╤А╨░╨│╨╕╨▓╨░╤П ╨╛╤Б╤В╨░╨╗╤М╨╜╤Л╤Е
+	// (PATCH /team/member)
+	PatchTeamMember(ctx echo.Context) error
+	// ╨Ч╨░╨┤╨░╤В╤М ╨▓╨╗╨░╨┤╨╡╨╗╤М╤Ж╨╡╨▓ ╨┐╤Г╤В╨╡╨╣ ╨┤╨╗╤П ╨║╨╛╨╝╨░╨╜╨┤╤Л
+	// (POST /team/ownership)
+	PostTeamOwnership(ctx echo.Context) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М ╨┤╨╕╨╜╨░╨╝╨╕╨║╤Г ╤А╨░╨╖╨╝╨╡╤А╨░ ╨┐╤Г╨╗╨░ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ ╨║╨╛╨╝╨░╨╜╨┤╤Л
+	// (GET /team/pool-trend)
+	GetTeamPoolTrend(ctx echo.Context, params GetTeamPoolTrendParams) error
+	// ╨Ч╨░╨┤╨░╤В╤М ╨╜╨╡╨┤╨╡╨╗╤М╨╜╤Г╤О ╨║╨▓╨╛╤В╤Г ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╨╣ ╨┐╨╛ ╤Г╨╝╨╛╨╗╤З╨░╨╜╨╕╤О ╨┤╨╗╤П ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨╛╨▓ ╨║╨╛╨╝╨░╨╜╨┤╤Л
+	// (POST /team/quota)
+	PostTeamQuota(ctx echo.Context) error
+	// ╨Я╨╡╤А╨╡╤А╨░╤Б╨┐╤А╨╡╨┤╨╡╨╗╨╕╤В╤М ╤А╨╡╨▓╤М╤О OPEN PR ╨╛╤В ╨┐╨╡╤А╨╡╨│╤А╤Г╨╢╨╡╨╜╨╜╤Л╤Е ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨╛╨▓ ╨║╨╛╨╝╨░╨╜╨┤╤Л ╨║ ╨╝╨╡╨╜╨╡╨╡ ╨╖╨░╨│╤А╤Г╨╢╨╡╨╜╨╜╤Л╨╝
+	// (POST /team/rebalance)
+	PostTeamRebalance(ctx echo.Context, params PostTeamRebalanceParams) error
+	// ╨Я╤А╨╡╨┤╨┐╤А╨╛╤Б╨╝╨╛╤В╤А ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╤П ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨▓ ╨┐╤А╨╕ ╨╕╨╖╨╝╨╡╨╜╨╡╨╜╨╕╨╕ ╨╜╨░╤Б╤В╤А╨╛╨╡╨║ ╨║╨╛╨╝╨░╨╜╨┤╤Л
+	// (POST /team/settings/preview)
+	PostTeamSettingsPreview(ctx echo.Context) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М ╨┤╨╛╨╗╤О PR ╨║╨╛╨╝╨░╨╜╨┤╤Л, ╨┐╤А╨╛╤И╨╡╨┤╤И╨╕╤Е ╤А╨╡╨▓╤М╤О ╨┤╨╛ ╨╕╤Б╤В╨╡╤З╨╡╨╜╨╕╤П review_deadline
+	// (GET /team/sla)
+	GetTeamSla(ctx echo.Context, params GetTeamSlaParams) error
+	// ╨б╤А╨░╨▓╨╜╨╕╤В╤М ╨┤╨▓╨░ ╤Б╨╜╨╕╨╝╨║╨░ ╨║╨╛╨╝╨░╨╜╨┤╤Л
+	// (POST /team/snapshot-diff)
+	PostTeamSnapshotDiff(ctx echo.Context) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М ╤Б╤В╨░╤В╨╕╤Б╤В╨╕╨║╤Г ╤А╨╡╨▓╤М╤О ╨░╨║╤В╨╕╨▓╨╜╤Л╤Е ╤Г╤З╨░╤Б╤В╨╜╨╕╨║╨╛╨▓ ╨║╨╛╨╝╨░╨╜╨┤╤Л
+	// (GET /team/stats)
+	GetTeamStats(ctx echo.Context, params GetTeamStatsParams) error
+	// ╨Т╤Л╨┐╤Г╤Б╤В╨╕╤В╤М ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤О ╨╜╨╛╨▓╤Л╨╣ API-╨║╨╗╤О╤З
+	// (POST /users/api-key)
+	PostUsersApiKey(ctx echo.Context) error
+	// ╨Ю╤В╨╛╨╖╨▓╨░╤В╤М API-╨║╨╗╤О╤З╨╕ ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤П
+	// (POST /users/api-key/revoke)
+	PostUsersApiKeyRevoke(ctx echo.Context) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М ╨╕╤Б╤В╨╛╤А╨╕╤О ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╨╣ ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤П ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨╝ ╨╖╨░ ╨┐╨╡╤А╨╕╨╛╨┤
+	// (GET /users/assignments)
+	GetUsersAssignments(ctx echo.Context, params GetUsersAssignmentsParams) error
+	// ╨Т╤А╨╡╨╝╨╡╨╜╨╜╨╛ ╨┐╨╛╨▓╤Л╤Б╨╕╤В╤М ╨▓╨╡╤А╨╛╤П╤В╨╜╨╛╤Б╤В╤М ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╤П ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤П ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨╝
+	// (POST /users/boost)
+	PostUsersBoost(ctx echo.Context) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М PR'╤Л, ╨│╨┤╨╡ ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤М ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜ ╤А╨╡╨▓╤М╤О╨▓╨╡╤А╨╛╨╝
+	// (GET /users/getReview)
+	GetUsersGetReview(ctx echo.Context, params GetUsersGetReviewParams) error
+	// ╨Я╨╛╨╗╤Г╤З╨╕╤В╤М ╨╝╨░╨║╤Б╨╕╨╝╨░╨╗╤М╨╜╨╛╨╡ ╤З╨╕╤Б╨╗╨╛ ╨╛╨┤╨╜╨╛╨▓╤А╨╡╨╝╨╡╨╜╨╜╨╛ ╨╛╤В╨║╤А╤Л╤В╤Л╤Е PR ╨╜╨░ ╤А╨╡╨▓╤М╤О ╤Г ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤П ╨╖╨░ ╨┐╨╡╤А╨╕╨╛╨┤
+	// (GET /users/peak-load)
+	GetUsersPeakLoad(ctx echo.Context, params GetUsersPeakLoadParams) error
+	// ╨Ч╨░╨┤╨░╤В╤М ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤О ╨╜╨╡╨┤╨╡╨╗╤М╨╜╤Г╤О ╨║╨▓╨╛╤В╤Г ╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╨╣
+	// (POST /users/quota)
+	PostUsersQuota(ctx echo.Context) error
+	// ╨Ю╤Ж╨╡╨╜╨╕╤В╤М, ╨║╨░╨║╨╕╨╡ OPEN PR ╨╖╨░╤В╤А╨╛╨╜╨╡╤В ╨┐╨╡╤А╨╡╨╜╨░╨╖╨╜╨░╤З╨╡╨╜╨╕╨╡ ╤А╨╡╨▓╤М╤О ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤П
+	// (GET /users/reassign-impact)
+	GetUsersReassignImpact(ctx echo.Context, params GetUsersReassignImpactParams) error
+	// ╨г╤Б╤В╨░╨╜╨╛╨▓╨╕╤В╤М ╤Д╨╗╨░╨│ ╨░╨║╤В╨╕╨▓╨╜╨╛╤Б╤В╨╕ ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤П
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(ctx echo.Context) error
+	// ╨Ч╨░╨┤╨░╤В╤М ╨╜╨░╨▓╤Л╨║╨╕ ╨┐╨╛╨╗╤М╨╖╨╛╨▓╨░╤В╨╡╨╗╤П
+	// (POST /users/skills)
+	PostUsersSkills(ctx echo.Context) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetAdminAssignmentQueue converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminAssignmentQueue(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminAssignmentQueue(ctx)
+	return err
+}
+
+// PostAdminAssignmentQueueRetry converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminAssignmentQueueRetry(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminAssignmentQueueRetry(ctx)
+	return err
+}
+
+// PostAdminBackfillMembership converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminBackfillMembership(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminBackfillMembership(ctx)
+	return err
+}
+
+// GetAdminFairness converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminFairness(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminFairnessParams
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminFairness(ctx, params)
+	return err
+}
+
+// GetAdminFlags converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminFlags(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminFlags(ctx)
+	return err
+}
+
+// GetAdminHighChurnPrs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminHighChurnPrs(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminHighChurnPrsParams
+	// ------------- Optional query parameter "min_reassigns" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_reassigns", ctx.QueryParams(), &params.MinReassigns)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min_reassigns: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// ------------- Optional query parameter "strict" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "strict", ctx.QueryParams(), &params.Strict)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter strict: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminHighChurnPrs(ctx, params)
+	return err
+}
+
+// GetAdminImbalanceAlerts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminImbalanceAlerts(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminImbalanceAlertsParams
+	// ------------- Optional query parameter "factor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "factor", ctx.QueryParams(), &params.Factor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter factor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminImbalanceAlerts(ctx, params)
+	return err
+}
+
+// GetAdminInactiveAssignments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminInactiveAssignments(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminInactiveAssignments(ctx)
+	return err
+}
+
+// PostAdminIntegrityPrStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminIntegrityPrStatus(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAdminIntegrityPrStatusParams
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dry_run: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminIntegrityPrStatus(ctx, params)
+	return err
+}
+
+// GetAdminIntegritySelfReview converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminIntegritySelfReview(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminIntegritySelfReview(ctx)
+	return err
+}
+
+// PostAdminIntegritySelfReview converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminIntegritySelfReview(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminIntegritySelfReview(ctx)
+	return err
+}
+
+// GetAdminOldestPending converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminOldestPending(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminOldestPendingParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// ------------- Optional query parameter "strict" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "strict", ctx.QueryParams(), &params.Strict)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter strict: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminOldestPending(ctx, params)
+	return err
+}
+
+// GetAdminReviewExport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminReviewExport(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminReviewExportParams
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminReviewExport(ctx, params)
+	return err
+}
+
+// GetAdminReviewMatrixStream converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminReviewMatrixStream(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminReviewMatrixStream(ctx)
+	return err
+}
+
+// GetAdminStrategyOutcomes converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminStrategyOutcomes(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminStrategyOutcomesParams
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminStrategyOutcomes(ctx, params)
+	return err
+}
+
+// GetAdminTeams converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminTeams(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminTeamsParams
+	// ------------- Optional query parameter "min_members" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_members", ctx.QueryParams(), &params.MinMembers)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min_members: %s", err))
+	}
+
+	// ------------- Optional query parameter "max_members" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_members", ctx.QueryParams(), &params.MaxMembers)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max_members: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// ------------- Optional query parameter "strict" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "strict", ctx.QueryParams(), &params.Strict)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter strict: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminTeams(ctx, params)
+	return err
+}
+
+// GetAdminWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminWebhooks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminWebhooks(ctx)
+	return err
+}
+
+// PostAdminWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminWebhooks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminWebhooks(ctx)
+	return err
+}
+
+// PostAdminWebhooksDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminWebhooksDelete(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminWebhooksDelete(ctx)
+	return err
+}
+
+// PostAdminWebhooksIdTest converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminWebhooksIdTest(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminWebhooksIdTest(ctx, id)
+	return err
+}
+
+// GetMetaEndpoints converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMetaEndpoints(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMetaEndpoints(ctx)
+	return err
+}
+
+// PatchPullRequest converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchPullRequest(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchPullRequest(ctx)
+	return err
+}
+
+// PostPullRequestAcknowledge converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestAcknowledge(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestAcknowledge(ctx)
+	return err
+}
+
+// GetPullRequestAcknowledgements converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestAcknowledgements(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestAcknowledgementsParams
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", ctx.QueryParams(), &params.PullRequestId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pull_request_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPullRequestAcknowledgements(ctx, params)
+	return err
+}
+
+// PostPullRequestApprove converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestApprove(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestApprove(ctx)
+	return err
+}
+
+// GetPullRequestGet converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestGet(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestGetParams
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", ctx.QueryParams(), &params.PullRequestId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pull_request_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// ------------- Optional query parameter "strict" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "strict", ctx.QueryParams(), &params.Strict)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter strict: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPullRequestGet(ctx, params)
+	return err
+}
+
+// GetPullRequestHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestHistory(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestHistoryParams
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", ctx.QueryParams(), &params.PullRequestId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pull_request_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// ------------- Optional query parameter "strict" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "strict", ctx.QueryParams(), &params.Strict)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter strict: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPullRequestHistory(ctx, params)
+	return err
+}
+
+// GetPullRequestWhyAssigned converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestWhyAssigned(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestWhyAssignedParams
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", ctx.QueryParams(), &params.PullRequestId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pull_request_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPullRequestWhyAssigned(ctx, params)
+	return err
+}
+
+// PostPullRequestClose converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestClose(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestClose(ctx)
+	return err
+}
+
+// PostPullRequestCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestCreate(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestCreateParams
+	// ------------- Optional query parameter "expand" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "expand", ctx.QueryParams(), &params.Expand)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter expand: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestCreate(ctx, params)
+	return err
+}
+
+// PostPullRequestMerge converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestMerge(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestMerge(ctx)
+	return err
+}
+
+// PostPullRequestReassign converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestReassign(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestReassign(ctx)
+	return err
+}
+
+// PostTeamAdd converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamAdd(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamAdd(ctx)
+	return err
+}
+
+// GetTeamAssignmentTrend converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamAssignmentTrend(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamAssignmentTrendParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "bucket" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bucket", ctx.QueryParams(), &params.Bucket)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bucket: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamAssignmentTrend(ctx, params)
+	return err
+}
+
+// PostTeamBlackout converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamBlackout(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamBlackout(ctx)
+	return err
+}
+
+// GetTeamCoverageGaps converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamCoverageGaps(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamCoverageGapsParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamCoverageGaps(ctx, params)
+	return err
+}
+
+// GetTeamCrossTeamReviews converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamCrossTeamReviews(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamCrossTeamReviewsParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamCrossTeamReviews(ctx, params)
+	return err
+}
+
+// GetTeamGet converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamGet(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "expand" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "expand", ctx.QueryParams(), &params.Expand)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter expand: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// ------------- Optional query parameter "strict" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "strict", ctx.QueryParams(), &params.Strict)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter strict: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamGet(ctx, params)
+	return err
+}
+
+// GetTeamLeaderboard converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamLeaderboard(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamLeaderboardParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamLeaderboard(ctx, params)
+	return err
+}
+
+// PatchTeamMember converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTeamMember(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTeamMember(ctx)
+	return err
+}
+
+// PostTeamOwnership converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamOwnership(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamOwnership(ctx)
+	return err
+}
+
+// GetTeamPoolTrend converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamPoolTrend(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamPoolTrendParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "bucket" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bucket", ctx.QueryParams(), &params.Bucket)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bucket: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamPoolTrend(ctx, params)
+	return err
+}
+
+// PostTeamQuota converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamQuota(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamQuota(ctx)
+	return err
+}
+
+// PostTeamRebalance converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamRebalance(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamRebalanceParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "max_deviation" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_deviation", ctx.QueryParams(), &params.MaxDeviation)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max_deviation: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamRebalance(ctx, params)
+	return err
+}
+
+// PostTeamSettingsPreview converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamSettingsPreview(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamSettingsPreview(ctx)
+	return err
+}
+
+// GetTeamSla converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamSla(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamSlaParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamSla(ctx, params)
+	return err
+}
+
+// PostTeamSnapshotDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamSnapshotDiff(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamSnapshotDiff(ctx)
+	return err
+}
+
+// GetTeamStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamStats(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamStatsParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamStats(ctx, params)
+	return err
+}
+
+// PostUsersApiKey converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersApiKey(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersApiKey(ctx)
+	return err
+}
+
+// PostUsersApiKeyRevoke converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersApiKeyRevoke(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersApiKeyRevoke(ctx)
+	return err
+}
+
+// GetUsersAssignments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersAssignments(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersAssignmentsParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// ------------- Optional query parameter "strict" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "strict", ctx.QueryParams(), &params.Strict)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter strict: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersAssignments(ctx, params)
+	return err
+}
+
+// PostUsersBoost converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersBoost(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersBoost(ctx)
+	return err
+}
+
+// GetUsersGetReview converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersGetReview(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetReviewParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// ------------- Optional query parameter "strict" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "strict", ctx.QueryParams(), &params.Strict)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter strict: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersGetReview(ctx, params)
+	return err
+}
+
+// GetUsersPeakLoad converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersPeakLoad(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersPeakLoadParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersPeakLoad(ctx, params)
+	return err
+}
+
+// PostUsersQuota converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersQuota(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersQuota(ctx)
+	return err
+}
+
+// GetUsersReassignImpact converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersReassignImpact(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersReassignImpactParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersReassignImpact(ctx, params)
+	return err
+}
+
+// PostUsersSetIsActive converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSetIsActive(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSetIsActive(ctx)
+	return err
+}
+
+// PostUsersSkills converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSkills(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSkills(ctx)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/admin/assignment-queue", wrapper.GetAdminAssignmentQueue)
+	router.POST(baseURL+"/admin/assignment-queue/retry", wrapper.PostAdminAssignmentQueueRetry)
+	router.POST(baseURL+"/admin/backfill-membership", wrapper.PostAdminBackfillMembership)
+	router.GET(baseURL+"/admin/fairness", wrapper.GetAdminFairness)
+	router.GET(baseURL+"/admin/flags", wrapper.GetAdminFlags)
+	router.GET(baseURL+"/admin/high-churn-prs", wrapper.GetAdminHighChurnPrs)
+	router.GET(baseURL+"/admin/imbalance-alerts", wrapper.GetAdminImbalanceAlerts)
+	router.GET(baseURL+"/admin/inactive-assignments", wrapper.GetAdminInactiveAssignments)
+	router.POST(baseURL+"/admin/integrity/pr-status", wrapper.PostAdminIntegrityPrStatus)
+	router.GET(baseURL+"/admin/integrity/self-review", wrapper.GetAdminIntegritySelfReview)
+	router.POST(baseURL+"/admin/integrity/self-review", wrapper.PostAdminIntegritySelfReview)
+	router.GET(baseURL+"/admin/oldest-pending", wrapper.GetAdminOldestPending)
+	router.GET(baseURL+"/admin/review-export", wrapper.GetAdminReviewExport)
+	router.GET(baseURL+"/admin/review-matrix/stream", wrapper.GetAdminReviewMatrixStream)
+	router.GET(baseURL+"/admin/strategy-outcomes", wrapper.GetAdminStrategyOutcomes)
+	router.GET(baseURL+"/admin/teams", wrapper.GetAdminTeams)
+	router.GET(baseURL+"/admin/webhooks", wrapper.GetAdminWebhooks)
+	router.POST(baseURL+"/admin/webhooks", wrapper.PostAdminWebhooks)
+	router.POST(baseURL+"/admin/webhooks/delete", wrapper.PostAdminWebhooksDelete)
+	router.POST(baseURL+"/admin/webhooks/:id/test", wrapper.PostAdminWebhooksIdTest)
+	router.GET(baseURL+"/meta/endpoints", wrapper.GetMetaEndpoints)
+	router.PATCH(baseURL+"/pull-request", wrapper.PatchPullRequest)
+	router.POST(baseURL+"/pull-request/acknowledge", wrapper.PostPullRequestAcknowledge)
+	router.GET(baseURL+"/pull-request/acknowledgements", wrapper.GetPullRequestAcknowledgements)
+	router.POST(baseURL+"/pull-request/approve", wrapper.PostPullRequestApprove)
+	router.GET(baseURL+"/pull-request/get", wrapper.GetPullRequestGet)
+	router.GET(baseURL+"/pull-request/history", wrapper.GetPullRequestHistory)
+	router.GET(baseURL+"/pull-request/why-assigned", wrapper.GetPullRequestWhyAssigned)
+	router.POST(baseURL+"/pullRequest/close", wrapper.PostPullRequestClose)
+	router.POST(baseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	router.POST(baseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	router.POST(baseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	router.POST(baseURL+"/team/add", wrapper.PostTeamAdd)
+	router.GET(baseURL+"/team/assignment-trend", wrapper.GetTeamAssignmentTrend)
+	router.POST(baseURL+"/team/blackout", wrapper.PostTeamBlackout)
+	router.GET(baseURL+"/team/coverage-gaps", wrapper.GetTeamCoverageGaps)
+	router.GET(baseURL+"/team/cross-team-reviews", wrapper.GetTeamCrossTeamReviews)
+	router.GET(baseURL+"/team/get", wrapper.GetTeamGet)
+	router.GET(baseURL+"/team/leaderboard", wrapper.GetTeamLeaderboard)
+	router.PATCH(baseURL+"/team/member", wrapper.PatchTeamMember)
+	router.POST(baseURL+"/team/ownership", wrapper.PostTeamOwnership)
+	router.GET(baseURL+"/team/pool-trend", wrapper.GetTeamPoolTrend)
+	router.POST(baseURL+"/team/quota", wrapper.PostTeamQuota)
+	router.POST(baseURL+"/team/rebalance", wrapper.PostTeamRebalance)
+	router.POST(baseURL+"/team/settings/preview", wrapper.PostTeamSettingsPreview)
+	router.GET(baseURL+"/team/sla", wrapper.GetTeamSla)
+	router.POST(baseURL+"/team/snapshot-diff", wrapper.PostTeamSnapshotDiff)
+	router.GET(baseURL+"/team/stats", wrapper.GetTeamStats)
+	router.POST(baseURL+"/users/api-key", wrapper.PostUsersApiKey)
+	router.POST(baseURL+"/users/api-key/revoke", wrapper.PostUsersApiKeyRevoke)
+	router.GET(baseURL+"/users/assignments", wrapper.GetUsersAssignments)
+	router.POST(baseURL+"/users/boost", wrapper.PostUsersBoost)
+	router.GET(baseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	router.GET(baseURL+"/users/peak-load", wrapper.GetUsersPeakLoad)
+	router.POST(baseURL+"/users/quota", wrapper.PostUsersQuota)
+	router.GET(baseURL+"/users/reassign-impact", wrapper.GetUsersReassignImpact)
+	router.POST(baseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	router.POST(baseURL+"/users/skills", wrapper.PostUsersSkills)
+
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+y9b2/cyJUv/FWIfh5g7YD6azvZyBg80NiaGSG2pbQ0O3nWNhpUd0niukV2SLZtXUOA",
+	"ZcUznrVjxUEusgg2k01ygXtftmT1uC1L7Rf3C5Bf4X6SizqnqlhFFtnsP5LlWG9m5O5qsupU1fl/fudR",
+	"qepuNFyHOIFfmnlUalietUEC4sG/Pm9W75Hgl03ibdJ/1ohf9exGYLtOaaYU/q+wFb42wtfR42gnfB++",
+	"DzvR47Ab7oeHYccI96PHYTs8CtvhcXgcdsPXYdeIHke74UHYKpklmz7i1/Bks+RYG6Q0U1qB15XMkl9d",
+	"JxsWvnLVataD0kypZtGRxGlulGZus389IORe6a5ZCjYb9Pd+4NnOWmlryyx9YZN6zc+a+V9hstthNzw0",
+	"wvdhN3wXtsO3RvRd2IZZvzHCN2ErfB/tRk+ineilaYSHYTd6Enajx9HzsG2Ex9FO+CNdlxF2o+3oSdgK",
+	"98NO9CR6YYT7dHQr/DE8CLvhkRF2w73o38N2eBg9oT+lz9kP29GTTDqswuQVOqRXeMPesDO35j/DVngY",
+	"bYed8Chshe+iF7AFbVhG+C7swEq3YSJdtlYgCKVCuC/PsZ0xxzp9vTLFDeuhvUF3Z2py0ixt2A77l9ge",
+	"2wnIGvFg9gurq372yfqTbpbv4XS9j3aibaBvOzyKnkdPE9PPmK4L79MfLXm2k9rZLpaXibVxy9ogeTOm",
+	"pD6mx9tYLF/Fbd+lk6NUZHvQMg26JLo4Y7FsRNsGHJ1DulFG2AkP6HWJnoSd6Ddhh64SzxyeI6AFO2DH",
+	"8BjYzegF/T0lxKE8iehpBikCYm1U4O/8A7bYrNfL5NdN4gfztax1/0fWlI3Fcsb7G816veLhgyt2rWSW",
+	"6D9sj9RKM4HXJPmzWrKdauYu/DlsRd/RAw/nBS5zJ+zCllyg99yIdsIjoNl3QKZO9NK4NGmEB+ExHv3j",
+	"sAXH6eBixuR9+nqFcKuut2EhgwrIWGADXTXzDjy7mnngf2D37TtKvuiFcXlyEiZjwMQ64Ztwn12FY+Q/",
+	"HcZZW/Q0IL8wwv3wiI3q0nMFPNc0ou/g773ouYHHpBO+puyAEocxbHhp1oph4vqbs2rVfSJWu+K6dWI5",
+	"sNxe9+Xv4TGeFpk5dcKjaFc5xtHzAoe4n+PztRPY9bxLTC/Wt4UPD1ztw2gn+j7s0PNzBFOHC5F1gpp0",
+	"BoOcoK994g1yEVHARS/CN3yvgR3tZs3PJ16/13KLfwlaw2yj4bn3rTr9u+G5DeIFNoFvLPiG1CpWoFnC",
+	"n+DEUnqDEN6PXkQv4dw/NmAf6Bmme/IOeUsRspliOdrTEK/wtrRueZaxcuGu/BupBvSRs75vrzmkVib3",
+	"bfKAeOl11l2L/rpiwcgN4gQFhdzC4twtKhjCY4UKRrSTuY0gDqRzx5nYMfDCNhzU3VJarOWRBr/DA1Gc",
+	"buI3po4A2ZSkX889bNQtx0LapI4NIzg7NsU2vkYCy65rF0fUl6W+P4vb5zTrdWulTvhlTG+nRyzfddIz",
+	"bbhu3TQaVrBecR84xDMNj9StgNQqq1a9vmJV75lG1XN9vwJMNf7QIzEBTIP4VasONKOMmiowHlmx6pZT",
+	"JVcNVMtA8IQHsCz4V4uqy9FTzZpAUUsR3g88KyBrWpU9ehI9ZmR7TWlCLYzn4R7IsZZxoTx76/rCTdP4",
+	"Zm7+y6+W566bxo252aXlyo2F2etz1y+OijVIJ1FQXJq3OHbaQ6SevPwLsegBd0lfhmrT84gTVDzGfeBD",
+	"OyAbvvYssw8sz7M26b/pw1yf1NTfazlx10ClQd483HnQxTsGyO19scOgnlKF4m14yPXP4vOStEL6g//X",
+	"I6ulmdL/MxHbpxNMxkxImunSuusB5ZrOql2vk5rW2ov15K7BdaSUkAm7aPmgNfcO/nohSNBmZga99Mdo",
+	"1EbPw6OwU9JaDPLxUZZmajZQuyvSkvJPyrJHnFr6nDBjWkf7hmszc1/sTx65E69apL/WbSHqxoUZdKzC",
+	"9byAsrYXOwmYKs5WU4BIOPMM8bLBXSBpzoqvrPiB5QV9KGzyCpRHmMordRP/vG5V77nN4BvbqbkaJkCc",
+	"mt+XNLRryljbCX56uaSXIng+qyR9kxzXIcb/efwHVMfo5T9kPPmYGhrUG1PfxAHvgTOgw2SXOhKi7WhX",
+	"+EWoTwUv1QFIwZd6YWB5QX+r7ONIATtXTGHxOlOQVyGHbp+uufeJZ62RL61GjtqisNo0ya1msO5mamKk",
+	"bq/ZK3VSqVpOzabL13Hs34F3qRPuMwMx2gFbEixGsAY6CbtKdWlRDt6Ovo9eoS7CPFsK42cmYnr665af",
+	"mFvSHqT+Fd+3nbVcoaOyaT13PqZLe8r0pxY9V1TfoNYujN+LdsDnyKRX2tnV0q4g6ZHQ8kx5TLEjlnZ0",
+	"pB8i776pOzE62ukPRWontAeWKnrUOM82XvA9vt69ktRMdft0hPov1YNbnAng9nWoZ/UA/MWvUy6r07ZR",
+	"+DoLkMlPU2mDbKwQr7gQTRP+ZCWoWQrcwKprdvGv4Mc4ClsGowBwa6pOUwfqUZp1tMKj3kqOwkqZZMYZ",
+	"mIJWOkrPOTUQ4PPOqqujcrDuZlxIK1jXfsFm5Ves2oatsYfCv8W8gsslUGpb4QFV6MJj8KxSfw44zg7p",
+	"WS9pvVwyAdhU2cRS09Cu3fNcr0z8huv4sIfkobXRqOOf9Dv6R9Wt0V/dWliufLHw9a3rQE/ft9bopx7x",
+	"3aZXJYbjBsaq23RqMK+EssAfpX6MD34kQirLc7M3K3O/ml9aXipRl7fy98258pdz9N10HrNLS/Nf3mL/",
+	"rFybvXV9/vrs8lzJlGZ5V3Nexbx73VeYWjw+TbvEeFyhjsRfECtoeuSLurWm06KoRV3Ti6zMe4UUz3Di",
+	"HkY74MEK98M3NHqE4RXZ8G3PGMx/aho+CQLbWfO5RU2c+z01SXbH+NzFfHSr/8peW7+23vScxXJR9SR5",
+	"VyT/ZjvF7NE9e2o2nuyQKKRBtMI3mjmDZHrPQn2yjtMCbWFbq+fk23TqzLSCXLc/8xvMgzJbJ57GMtmw",
+	"HlaoH0GvN24QyxFfxxLDbVI3kXib09xYwfFUV6XD8cQXklo3gXPfoO/Q7Ge+/Gk6tZG+L0fgxJQwY5op",
+	"C1ano90Lx6oG9n0yqzj91P2w2Zi8K8N0jbTP6xjUbK1eG20btl/BZ38GQZVTvFf5J1uzZB31bhCrRrwV",
+	"1/JqOj4beOzPQqdAeticE3ibH0xV+iHci74P21mB85Sm1A33FZUW+OMQihMnXA+KI5HSirzl3NNzjmwV",
+	"X+fVlhzZ4b6xWDaNaDs8il5Fj8MfpZNNHWRK4OwEFXpYmtm/Xo/85dq65ayRNMGs1YB4vQ4n1eHxMeAa",
+	"IquuR/r7zQB+Z/Yak00xe2k3mDhQF+Y2iFORNv1U7Szl5dkzR7toKbACrbXlrQlpWtQ0FVboPo9GPIHk",
+	"kbYhtNk0IZKkGuo9NB502matsgAzSTkd/RdoVMhftxvlZl1zKyBolCPoinHBPqSZFQTE0xluf4l2eAIM",
+	"vI4qzW3j2sL1uYVvbs2Vl2aMtbq7Ylz4yfiaaxo1t+pP/GR8o3aRq9csKA7O/fC1cYFuiOdY9Qk/cD0y",
+	"YRpWw574yU8u9tTB+RRNThwdWRfL9DA3/S/sh5kHOse5mRHwkwxguqdu06+M4lkFPGA+rGYQtxf7pXbK",
+	"pkQKLRWJU7OdtTytjG7GRqOARQBe6ffRczTrtZFWA/L62lTCgWsaTnBXe4erHoEoaj8OavKwgT4BXUT5",
+	"LzTkBEc6+i1P31Fiw2FLXsIhD8S1mRv++7AVvUSPRuH0CIc8DCqMgH2tpMiJ6cMdnz40sl4kdjg9YYWm",
+	"yqZoT1PdqpJ1t14jHk2nSZ8leR5F9SPUiKLt6Dk9L9FLaixHT6NtLhrk3YwdonpXtEKyHjmGytM4j6uT",
+	"Nau6KaUZRjswVMkNZI70HXDuvYFPWyOKkMvbphJTux+uWx8mfpl1gyA8BR68bfjjCC98MjO3A7eKKqz7",
+	"IBXaV1Of0ejvHqQEi0cxCZfIR5WuXiEjRyz9I4qnJuac5sRoKkq+cU20675lgzCSh/UdzTLpuU5GsJBN",
+	"voiehW3jSlYyjvbanUCEVyWFbt1aCsfmeVb6nFX3taYqT5ATkkzKTcxwPFyVE+vE77bDY0h8B3fFNiVh",
+	"TjBuh8UGn4f7/d8BkSmoOf1F3JOD+FouTI6PT1/sSyPND9AykTM7hPqFKtDsCStwRUKY3Hyu1IhVq9sO",
+	"0QaQHgM7jcl7FfQSprxAXP81yEUq+jBZn8rMx3LEhZ46nk3TiTPkaU6NNqZYMgekTKy28kAHs8qEEXjt",
+	"xsLSnD5iUVwcq7IYErPlrD4oNnlDR7JbBimso44fC0W7oP855QxMs5zcoz+6UzfELo2KajoClZlff36j",
+	"YVX7Jk9uxkYiWKEzorGiBb/A4/SaWsBY8XLEGDY1iFMXpnXVmIREHCr/eErbcXz79uIiJzyhz892YkSP",
+	"rIayFH6Zu681Eh3yoJLndXGrkOjTnznn1mvyQ7OyKKXtoozwqkGZFs+TQk2TWhSsCiKdK9suwuey0n/D",
+	"v0ChB03dZAUlWg8WTUrmRXrJw9STU8l0MBVSq4TV7x2LgS090CVRrXruRu7GFTJE3UphUyZ9OJUpKA/T",
+	"r4dy3Fx/xSDp7CcZ45EnlL0k+ZLlG85Z2+CRBrW/a5WVzVxdbqizqCsvi1+bvTzizVbvOe6DOqmtkYyN",
+	"iwcMUsISPYHcY/Bb8rIsaoTvs8K/d9q7L3MLlqJ3HLYTjxtYOxokBz5BBR1Jl27MXnM3GnXbYiZsMvUE",
+	"v9OQkDpTgAzUJKE61TPMPEgm+LUNMDy2sdYvYcVgKV8bvWVvwKGXVGu14o54VX2xxx9AVO8aYuJAfwPi",
+	"dIZwBkTfcj9d9BS3TfLD4NjPjMmSqQnWZ2xUHLw/jXgnVqQmKGWquiurP0zG+nT2eLTNDQX0qdLtip5E",
+	"r6gnRjjk5apqPg6J+QyGyMFH/JEafBw0xBofwTjcyvdfe6JJfbWcUZIxEENXVMeU/2NfVNEmas/f0sPO",
+	"3XbvQJsDOlOXV8dglpfO8C2ZmabryB2/g4cKtNaMNM3ewmrJsRr+uhvMDimshlAY8tSDJVYqtNAMqu4G",
+	"6VmNMFgK7r6J5VHJehURWnhrsGodUVHFiud1Dru1yqrt+bxipeKTquvU/AzPQJtVU7cFBES0i9xSYwNj",
+	"9rbQgpm+TGd9wCqiH4O3Nr1UE8WiYK9ZPxKiYA/c893BuC8Uc923PCHPUvKBiiJYBkVdYJ5/Bo3xBkI1",
+	"eh9aseK/AWacCmSkN1autss/4mKkWrmSfEuSTjlnR3c1aJaExgBg9YhQnejn+2FoWUNG5YPsJ1Zy/rjP",
+	"UqNr0mxgSYKjRU5PKWAFyBGVtr404ZB9TfW7VvSEseHijsd+k8zVPJPk0/iOJj2qrJh/2hysIkOmJUTn",
+	"eTqEkgJwVaJjS3bvR0/TW9bFeyG8+WbWnmHSOnAR+nPAoQE+V8pHIBk4WpLnu5eon84c5Ol9+mRj+jWP",
+	"D+TGQ9rhsYGVl+04hxXZDKhDsr50IXoibR+r2SQPG5ZT+4xe1IuapPaeCTD9lD33MYHTyY2JdyFr/5bs",
+	"/0Zyiz1O7SRxPaanhlCIM2i0ohPnNziqcp94vq31TP1ekpfRb8B3foTsUw62MviN8CA8YKK9A6FZ7syc",
+	"ulga8oInJmpq96l30aa8a9ft1VXNztVqVHE9sf3D5492Fzfcmr1qD/BYJddSK442EGXjxMjB3zBKgiSO",
+	"jkrx9Cs19DM1x0BPjcxDlpUkOcgGyXmXfeb4D8jd9Jk3PSRkj1qDk5MZ8qry5QddV3wgr7nNgYrNT2Oh",
+	"8pq0i+51Cr8hK+uue2+puSIx9JSXb5AEufskK7UHdJ3oKZh5z9VMW0DBUiRI2/iivHBz7E5zcvISWV64",
+	"avzECLuxAonq+bvoZbgnjGH+tL409MJ19U2vXrAonY4UhOiZ0cZ2Ypn4QZn4oMg/yqr/S9WrUd/qHohY",
+	"sM3Bh8S8BWDDMocbtWfeAmF3IkQt7OlXrlsBcaqblQ2/IH3Q2VPhRYnqVL9aXl4ck/dIQVFktj9iAEKg",
+	"65AC7SX8AwjoSB2L2yL97YD70AoB7PjSaa8U3PikppF4hLpuhWxmZlUjnQqFJbCDzSXKynlykP0Lsjnb",
+	"DNbT9MPbA3t8zCHX0Jd4SC9B9F02PNGFxYWlZWOC8gZ/wmrYY/fIpoA2W4calBg77Fdjs4vzY78gmzEl",
+	"cFpYKmF5xMuY4O9yam+xbnz2+s35W5XlhV/M3Vri8GkgI+Cx8QvXg6CBkGQ2KykO7KBO0L3NQz1GzKeN",
+	"JeLdt6vEuEDvkLFs+fdM4wurXjemJ6ev0KUKBbY0NT45PsmNJKthl2ZKl8Ynxy+xql/Yhwmo952IOejY",
+	"r5ukCYd6DfMZ6d0ECKD5Wmmm9CUJZukv4hn9EsbTg4OVwfDY6clJjJw4AXNpWo1G3a7Cgyb+jYV+pQLi",
+	"BiZOl2ZuyxnSU6rTt0TXODY1OTZ9eXlqemZycmZy8l/VnNrUmEtsTCp1ODlwig1MeVtLDW9sanJyqrR1",
+	"d0uGlUs4afkCCqoz6UzxXsobf4Pmim2ZaW7J0WEPoheiZD7GuO0YPPWUoptA2djbRLo2ndDlyakC+xjT",
+	"JG/Fav24ftLURtqB/z4J9zEBS0RfKKdHVw7jBrkV8DLfgVMlX+jbd7fuUg65sWF5mywVN3wnsgNfYCCj",
+	"G/7IfGEvWUWxDBQDkd+3qRT344Iu75JZCqw1n27sLJbc0xlnXMcJj/CaOdfPSMYXk2iB9Ii25VRHFdPm",
+	"EOFen0MWRyvavSpBZLVR0NDZy+k40Svhw6KficMFLsf3GORhfh4Ua/T799SdFj3HAfG5MhM8ZdH1tUyl",
+	"DIvGS0D84HO3ttknU8m+yjkXud9Sgd7YunFyu5zCbgJ9O/TucZ+u7ICS4mSYmoiBENVPOW6EP5wB4N7+",
+	"Qk56Bqbid24NJFCy9jS+TxWJT6fCydQ/G70SiQvi/iMbygWqlGy/htdHxkuaWF7J1M23ENf/L5ooF+3Q",
+	"zULt8x+LpdPJXz69yaOPGOabZHp9ipc/M7l7EL7jCPOqLOmIWEQyoSYjkIGwlcBAwjfJyeWJFhqcokB9",
+	"Y8znsW43cuTKn4WnXk6Zpp5O+s/HaM9AogRbxneMYVBGIXDFaXBqD2wgkBKd8ICKEwPkwyFaRXTXUU25",
+	"QsGkFSQmM0Ziex6+lX6WiHFhhDg8BgZ7GH0bdvKEzeeMEDdjOgyrxDJdFc5DIqL5M0VolOguEEeOvs+U",
+	"mpen81VM8fiiKmaifKyXgsmfX4jV/FWbZRO+BjXq2aemPwpq8HvcToUN9TYrPbhjCuXa4SG/3glAssWy",
+	"kgqNTQQg0Av2bu61X7VszyG+39Ow+4IPNJXuGrf1exMPmZCg7rfuDnuTFL/j1LRZWrMduzQzOX7pZ1cY",
+	"gIoy5BLCp1R4ug3akfKIIhdwSnYrzpRm63aVwGJYOpswGSenlsH4ZCYjgLXkvHtSfXfD2sQv1Nuvvvy6",
+	"dZ+UtszEk6YLrOKS+qBrlufWYRV4SmYu57CYnv5e3IeknMDs/Szbp0WTTZhwwiPPjQI82LQ9iUmP9ruw",
+	"gyl1h8YUPDHawbt0xHqydDSZijq05lEk0aQPWWHYIuko6HIzjSs5zMBAh18LfJ0wBvyeR1ICaXiUsWgK",
+	"bvwMysGlzKbXQIBCAkMXEhi+0jN5O4YhSZxlUZAkx+xInTBJ2NXqWRqascgCsMw8E5SzejUelzqs7KYm",
+	"z2NqNwrJ+j+F3ei30W+osQhaFcv++gPYR8dccdNdf7ovxQWhBpQHdIjJU9QhqKp+CLrtY9YE6ZhrnYlZ",
+	"fRqazQ+YJh6XbtHWUceYBIhOMO4u0N0/vfEiAXjSBOHocfgasyzBjcH19hxlpm6tFdBkYNSwmgh7120J",
+	"f5H1kWHylWeeV+KWAzHM4YxIWMvV7MWCCvEkGSWyl06PTy50y3+PyWnp9j3RbwBZ5PWn7RJWmue0jZSi",
+	"s4q7Miao1cvJu26vrY9VKd7lWMPrfZxjdExPo5ynOqp1WEZS735qOmxJfn8vhPs86CbXPYfdrH5BGzZN",
+	"x0Px4uvbMF3q1W6tp6khNZMrMFpu3lZguNyLr8BwuVPW8HZPwmtwW497epsp+VfoxU6W2kmVGmjRZHvB",
+	"teWwpdlazfCJ5VXX46qGGSxwTqOaXt66yytSZqYKetWLczoZEVaX7cMLg3pV1PCKmR4IL3oXIGumtcci",
+	"KdhKS4fWnnuVzpQmg4bXawjdtFjuOA1CPEa7DHh9eMxlscJuFfGAXIW1pJR7u2E4CZuxfUYNrE9JctCq",
+	"l7dU5UWspTSibzLDPxfdFwO1HfCpddHoweTzbq50sTlY75hVJ17QW76o6L69RczvIUalwzAOjzT9PFvp",
+	"KpMW4mq8gwYOLY4OhkYsOtJiqy16Gb3MEDmrVjVwPb2smTZ72+wj8FYxCt+WMZCvKJDHU+NXVEjj20mc",
+	"yyvFXFEZ7h+nlvPoSeXR0+qjP3dXqHJ61+SEnJnOcxCJw1SIgauHSsfD+UsLOFeSqi3fdzanoqZsHD0F",
+	"xwK/fIcQ3xCeBKkY6gyx7kOdJf5p8tbwMLWVx2E7baByjB6NFxIIeJRA28rmqAxbeizhFMznqimc7uFN",
+	"0rSSqEP6BiXxtPXD/BSpgXTANAV7Z0oNouexA6S6qyAsmVlqh2WicufAQ9FlLjz6lI1lVmNlylksel9Q",
+	"qq3TdkYIrYf3NOfaBmSNrmKi4Y3Fpd484J0RHZ7nv1r0lgQebK4+9LckdCtL9YmdY29ZlS8uhkFHgAWB",
+	"hcTH3P2CXu7dzKa3NW+z4jWd/rocD63l8LfyN6TZkATtm8iuvHR55spP/1WPqTsDAZ1cNiS4DMPXymUz",
+	"Yp66wozBeJAMjtyL+cSb0z8bCv+DCSkqw95Jh+VCfImT54jZWuy1F43F8qfEeCR9oGOEHYl8PJFTaAbb",
+	"EEJ8B4pAl5nynMXjAaPPEOCGxXiKT+qrY3Hz0x66APuVhM5xgg6jvIzplBJQJM260A1FPWD0aoBEs5MQ",
+	"/6YBSEhtKeciDi92smruu+HRp3nZ9ARL+r2wTmcP5xl3oc1CzEzeN7OwkD6/UGfsQoV/Z9VKr2I5pcuf",
+	"/YQuz98xERLVQU2nyYxWS+kLBJmVueKJpvz5wZiU6pwrlxZgOCtI6Tvvq79gzOlHV0Z7a5TU7ZFfGwFN",
+	"wdL8DgBRFXc/K5wu7FBqlnIkM2GSnh3fVZ2ek/NQwojsavScM/R4zKWInkolC6JkqaBzC1XYMfKwwYCV",
+	"GcdIUwLrWOP65QRS9nu05xEWh/bZllNWZYSpOAGEekv24Gn4OYagjgDV/UXJzOBaKLvmcMLDJKv25kJf",
+	"O4Fdj0cnaPI/4kJuXIu0yqyQBbq6tfZ7iZ5ebKeG0NJV/37JZJ9q8KT744oPx5xaSuspPbpTalDl5U5p",
+	"5g7XQe6UzDsl7k/k3zWnpY8rVEch8Pm1hZuLN+aW567D15LGBN/K6g9Pm5Ufnx54ZXnqpzPTbODWHdXV",
+	"ka42CsjDYILSSVkVLMmUlmDK8zalWZryRBxGALM5bYp1mbo1mNr55k9Wk0kPdQtdOPwXcM6GPGlDmbUh",
+	"T9uQ5n3xqjJwxlicu3V9/taXpjF77Re3Fr65MXf9y7nrnGuJhZ3NDDs+TRkl4VPi+7+X2EhWbVBGYWk6",
+	"iTIuJ4Dazg5DRegpDDaswLMfTviBx6DuRiQTWAIgzaWCKn862AhfhX8w2TddyNOVYB+BiKxPQUbpfw85",
+	"cRPWsoRLGQ3HZCFVmS/ysCp89rm7Ah+KiC18ymK28I0AaLnDstDvMDvSv0OPyJ3YqsSXTEncFUJJd2hx",
+	"xJaZHnlJM/Ly1t2tO05y4pfTE79uOZqJ86qF1MzRHaxM/e7WcFyQzdA0+LxMQ0zGjJvPmgZ758Wr/K+Z",
+	"jCS3Hsmp7bgRxmJZlJvp2mB92kwIGDEU+n0b7SQIaPzvPyrOoGGzfDmM55iL4LO9g60JtNoPXMPUo2iI",
+	"Lc8mspeJZ9VN5gHZXrk8OZkCeZ0en76SCm9MT8q4qaXy7K3rCzfTVUVTP817HYZnEq+bHP9Z+nX/rLzt",
+	"m7n5L79a7hWt6bOYRKZaUUeXeip6mu280kJ6VcHi64wUgzS6LsJvHKIDiEc8VVhhGUkcxaXgSRo05M55",
+	"ocQHLwEVqSdSlxulGJ/hgCkb93ZEiCECbjiXQS7DqBEmj2uxcAdKGo/xBuOjINLEJ/Vp4ql5p9MOT3rm",
+	"1sOBZv7JJrizc3pbwn+cyqiO3TKlQZf1mY9S9nle1qK4HYUBNQFZdwQp5/jmAVITmfpE68oxeS16kp98",
+	"rjvQZ0gqUKy+Fjj2qOF2fJ56fir+Yk2SpIYZ0pCsjh0yZwJHeYCByY0M27ly6QECPvYWTd/wgUOr3RJo",
+	"IXKazFCspI1zJE/s5MeQOFm20V0EzpxisJiA2efPTExU7XH23vGquzEB059oeD30XXV6BVmSDsG0px6r",
+	"vKkQC/pLjEzJGnZnMyG7Jnz70Tbr6t1mXQM+5Rv3PknDYwQsxaS+nSQU7GI5N/MhjUEe7kVPKZAYgoqK",
+	"ZLFoN/a3tUAJOo6eguKIcEMquD1zCMJcGUjtLuu8yxPi8WOMMGKefAxXxOF6gNVC8yOGzBo9Hb/jhH8D",
+	"66er0CKBQ/fVzdlrY0tfzU5f+Wny+BxpaNgR0woPEmB0b1ixJUUB2Ac/4a/G2HUZW7LXHKjKnDH8dWv6",
+	"yk8/g4tdXScP4Q8yDn6qjPQShSUNCEHXg6/4pOqRoDRT8i9VvUtBqTCLyWYwMSZxcVxgPg3N0EJIwE0A",
+	"AWZPEcx0MJy3qSFi+n4C4blvlprDQgfhoC21i1Dr7OhjX5dvmMrFkyIuHTRZo8c4+z1AjxMljJ8OW+f7",
+	"CDk7yG8G4OVpXWiiRuokIAWy0DkHuo4/GIIPgQKTwzUGA4j+IGCOI55qrwvMcLexPPMcQLGvySeJiSUO",
+	"cg57q+8sugNWGptWtqKdUV3QR3ZtayJgBVMZqthfJdbYNthPx+mP8vQexGE1KCaRKcB4j3lFLyphtIuq",
+	"imKv4MGHLeMKZ9071LDrrcHM15axd27C8QcuLYoFHnu0WA9a+frKXKP3tRvaRcQaALCwg4TM/8+XE8D7",
+	"0zQOksK5V9lcARVA6kZQEFT1QHQp38dueU+YMS31ApZEZ7R7zjc+MN/4QbKVYjwXac/aqrLT1rRpiHYy",
+	"uMcGCawJ4tQart2jJvQmCaw5MXDomxK/EhyqwboL75lbZhD/pRkVMkncbL8CH3PxLP2YNlCQft2I810n",
+	"0I+ieQhkAOR6PRTiFPJ4cCrN07YIvVwd8eMLyfg/gucRAjAsINNhSIQSmillvY+jZzRqR0M3eN5y4IG2",
+	"2XmlLYRFQmb0W8qg4Sh1AHEb070TDaKx9JHZ5MfMCsfDKp04enbYgaO7MibV9DasoLqe2X91n4Xd2wYa",
+	"npDicAS5ogBELEoO45shw5CnmcORxByowT6elkB0QnKu9ImguGfXJ69SAtGsQF6pPCTgu+Y957DwI4aF",
+	"11H59K2L/tHbCzTjCPewLFwqiHkrCjVPH9FcEaU4iZ8PpKM9KqEiVlosV5hTyyxtEN+31uinVctx3MAg",
+	"NTtgdZWw6C1zhOthXdjZ2+lapqdPU1OhlgXcQVHhJNhtUmT8R4LPJser5ol0zHwN25+wqvcc90Gd1NZ6",
+	"eBKkB81KvzkhjqwAzZy32ThJfnpCXd9HxWELHhgZsuRRSTrV+oDdNMfcVs+Z5pdoQCrA2IXLKLNOpNKL",
+	"upAey3t4SVevbwyTCjPK+bsLdoB6gqF1qdqGaqS6lPEPgRnwJ97L7Thsq4VuAhD4WNirXfX2wXcXONKm",
+	"wehWgb22GnblHtn0L+KSLn2AJYnGcixEBowee3/8SJdnhAeQDEjDVkfUa6XPaX/5kekHo7T+09R4IU1N",
+	"qTHXFJNzPk4dMYvlpByObwbIYdOIvqOjU0+igmSfsfF3+uYsLGN8MLHdE0FKL7nxZ/2mNkvPmq8VTv5a",
+	"LNNkqFvWBjnxGtxPlOV+8Nud79YIu8qatEvh2QVH4iZB1kFHuT9hp8970mh47n3So+ed3IuvbbCEhb3o",
+	"cXxBu5LttcuaBsH3R9HuOMWwB6fLEevVTl2N0Q5zjNAMiOgJ/Xoveo4PPw67+rfQ6qcYILqdSOHmuGHj",
+	"Wle9fM3Zqs+V83Pl/NSUc48N8tz7Vp2p4fAvnQo+JbW9UU7TXTMLqxoARwGL8GSxB83TdOqIIxWDwh+F",
+	"nRRzoC0wPgRu/rl2fa5dn452zU9R0hc3ce3GwtLcdbyXkvIt7ocAaEu+tBhKVC8FginVBXTrL0nwodTp",
+	"M16t0T9DZ7nWszmp1gJhrwDwY58yIKNIpKi/WRUCmvv7YfL3hqp7OOP2RYYY1UPu9nH7120/cL3Nghzg",
+	"Kzb6nAvk5jA/KjnkQSUBJe9Wq03P61Fe4dZr8e/otd8yUw+7NMqHXcl+2JXlyZ/PXNI+DH3E5mCNwzWJ",
+	"1/leirgGe+4+0feV622I9bQlcpOxs3PaWHY9tvndDQ8QgQNKQViFEFrVTMs1DSa/O6zXA+/Zl+rIh3xK",
+	"yYvuoHPgnLmO2nkjd2Z+mbEfnfwOUFnOq+Ks+MH65pjc/bEAP/5mfXOW/+KjcnQOFvPKBBiVmF6NBJZd",
+	"p9f3geMbthMQz7HqE1RukYmSWSIPG3XLsfhpsav3SM2wfMNyDPeBQzzDXTWCdWJU1y2HBuJpgpdvXNA9",
+	"7aLR9G1nDYYjZITBYR2uGutWzZgy3AZxGNyUb1gBDA3sDTJeYkgRViC1o4TaOY9YQCRILavAnEo6dIqU",
+	"X+HUQ3Zxp4E5iagn7j3+C2ug3sH2uDpYgAQwTVpLOpNsiXrn/j3ajbZ55gPiPMG6qIGuMQFpraraby/d",
+	"Rqk3CxKJi3XXL54kcQ1Gn4gH9tzl+gHzy07TmfqBHKGyy+VUXaEMqphXMTCdjk/nk8hyAyZzWmluKoP9",
+	"I71kgPfLPWoMczUOa11g1++IgfHhRWRt2bpMAwTQhWj3Yh+cFVPCC7NWHN4DgEZcGuwqz+sUdvQ4iIiI",
+	"nYGebSDrUPGXugx1B5Ogj3g7a43AzUB9IQ8bFrRdywa4uzuE/Bglc8hr5Ra/RpfxTPVDjZwRSYwStHv0",
+	"G7ho76LnVw1oXNLCmG/0Ivo2eo5ChCW178DZS6AeUbTiuKCb4WBFO9RkAS0BJRJgARevaW54tou1ITK4",
+	"7q2F8s3ZGyW98mV8Nf/lV2ARCRggXCV6vXlj37axagFOGr2xtJu65zYDqjAL8C/UbgBoUyxN0wcys699",
+	"3Kye/pMHrOkY+n8Myu9DUL6FcJ+XJg3W2/7tVVGXfwxF/OjjB+v9gNc3RE8RqXgPCh8k8kuzCdvY5Zjf",
+	"nB/jnjMSOLGgJyWdBp7YHGF6vUfqgOOheaKmnQdUy4kGk3G3t7jjFNeidxP4Caj0RE+oCqXVs5PqefL8",
+	"dkEHEn0r06dYszTUQir+Pbte9zNKOeiWHULnMalrFgg6zFmgRyo51R3jAswVQ2UgEEy65Df0WQgjTssI",
+	"GRfkuDsXr+Yf5vCQKWIINo7QZjHe4xFvVYQz5s7b4peXYRDy3upF8QEHqDCQdbGTAyw4Mxpi3MaZHTjp",
+	"/bl9P4VNXJHaSqRsEOVQYHIQ18SiZxSig3a0NTNy6fag1hpSdGSwkTZm3ewDvDA87oIKZsLYXRKpaHZp",
+	"af7LWzfnbi1XynPL5f+/8s38resL31wsmZq+YNL6/Gaj4RHfJ1rOonguBQCOvi8Dxz2mRi7PMdxJYjLJ",
+	"0XlepamAN7+B+4P8ioPKpxegiiQdB/mvNBMDGQDRlWMOOabiUoYdVdhoKc8l7WdUBujJ26fVYZZ+3XQD",
+	"q0IeVgmp6TYi/D1lNDo+xOo1KQHfY6l4nAQBZioqFofUl00Lw82e7J35I7bZt09Rsr7SLpTLKHGtKqtW",
+	"vU4jkZnlecpLdEpCuI+WNjvZGHbXHy+R+sAs5oz7KNSCDuD0aHc1Q9pezFh2mp1oKuYTzaI1GrtUF0kv",
+	"gmLzK0DMV1HrhAaRiGUm0JZSuQTtGNSIt/RCcof7hoYRp4vazVLeuv6WJh5aCJ/Jz+zD9UhqPIVVC9ZD",
+	"VYT8Y9URfOY1K/DMPgzRkzhTVZLZiPv0uCfPgGcLlGeVtWWcK0XV0Z2nwvlXMScumaV1YnHOd8OtMk94",
+	"iji/o1eEHhfl59LFKpmxtE6nlPx/ievwWSyEc9DOzwLsD8a2kkIXD6opeLjICXsvCtveSsfi9KEAfsev",
+	"/ESSGeBEVfbICivT/p/oOU59eA/Q3K/ml5aXFA/QYtmwa4ZV94hV2zTIQ9sP/JPx/wCkw/ccuRG5JBY9",
+	"/vxD7Incs59Czr0z6J5AA4gniRBvtEv3pZjmdq08N7s8VynT/9yYvzm/XFmcK1duzt/6ennuonrTyyTw",
+	"NsdmVwPiaS77/2RW1xtDcSd3FfgUpRZeVjxZEFWCXtHd8hj5ZCvhlvsrX79wy3WECEMk/WzRxd59EHaN",
+	"6Yyse8bVFV1SEpDFnXjgsyzsw7sJo8/DI+fhkY8s1zzOO8xsTj0SE1v20Y8mCPOJmhVDxZ4kQICPLvYE",
+	"ZwTiScDolA68yEzeyEVLMRYamjY87maW7lv1ZpYeIwalIllwUTCexSJZW2bJcXlxkmZO1IZIFT4UrorK",
+	"m+j8raWvv/hi/to8dePMLi6WF/5l9kZK+3IIqUEeSp1YfmC4DjE4jzFWPXeDZsFwhiF6qzHFfOQ6GhJW",
+	"6Kc76H7gHf6HJJUm40sqZRXNiw451M8Jxf08lrxYWGvg2Y7DKA40VVNqjMcTUAfSJ5R0Ua22cCwgidGq",
+	"wHxH+DO5L1dFwFXsNDrt2hhmlEItEkhzliKoiw8oWaqPBguynKtHw6ETyXvw4XNJaLZ088qpRAoadatK",
+	"apWVTUysHq1eIz08eSoZsdnNy85567mVXkl9U0Eg2Kyk2DbeaEwTPoYPux9E0eCump4VXSeviITH2K53",
+	"hGoIlzGG6ySVkarrrNbtapCaVN45iTuOvAvfsekfJ3sDgg8jrh98mzT/M5dSnsN4U+Xawq0vbsxfW1aW",
+	"xE4fjS8J/cN4QNNzuVZSdR2oVnCC+iYc18DbhLRaAfMHlQ509bZz36rbtWuWU7NrLP0mpoIk2dgJQPcG",
+	"NOjkcLgvisBm5Kpl/zJ7Y/565drsrevz12eX55TVylPYaPqBsUJAA4OmjdDJ0cDGPsaDddewfYNuN10r",
+	"sjLD9YQzjdMH9x1NuAGOokjbyjuK2bld8lGUM7xAL87YB64XM6zYaCd8z1MvNAZU3tRuLWTR2eU0lc9X",
+	"lc/HsB0gdqzAS1n4qbKBLP6xFz3XoJ9kVWfmLGK5gjckQWJxHdg5EDcicI1g3fYZpUenqVP7mF7w6FnM",
+	"zw94IhjfIoEVS9eeWR9BgX2TCnl6qNSKR1Iqc/gUKI0xE4pjOEy5VLSpfKWd7v+EVavl4Gj8d6ZzJfzr",
+	"OR5nM1aG2+nOQR0k5x7vS8KTRkwj2kk9DvvUR08TQB38N6IBLPWdir6vVzUvVZNioqdxbEth4QC7Db4K",
+	"husev2vcSEcx6Wu5p6VCaenHLOalTgEWGqzcr4Qeqqup5wgTgeGSKAiy0bcImoKbktWmhNaszNZqwxhV",
+	"6qzoKeJls3fNuP0axQrn1Oeg4JJLjmOLcIWyblcJVN3k/Wha/dHn7gq2c9P3git4+5cFvztJkNCANcQu",
+	"MJNCZXeaO9GKdpM3Ur4icTuCvhOQ+OQ/9OaK8uwejftGSGjVzE30ZhlBBPltmjdLsWTgxTSGLKjwmTjg",
+	"owkf50QuucZWnvvl13NLSe00xfeE2sZ9alOjDGhqXiilwLaNKW7bJHhmtA0NFd7JfpRjhg+vkyw81Sfe",
+	"kp3iELE5xARSzi7PL9yqzJXLC2WFmuxe3Z66a1xoTl+ciWUYEJXqOCvEIBuNYLM0WrVG1+pHTvvVSevW",
+	"1RSbOQ7b0tHmzbZKudFNlcjgvEm9CdNCcWNBReAWNEdQz9jAC+pkJnSYXlobmOakyE5NbH8rK0Yi628s",
+	"8IiTW54KslaMX4bh/dam9l33Lzck7z3682b1HhlZ2f8KPA0SbgEZIe40oPYqN9nIih9YXpDV8DzVdHwy",
+	"+3fT8u9oH4f8TupDYX4ktzRLbCT7B2cUt6OqTt2U2My7Bczq6Ow0/oqeAagTS+p/DyrnY9Y6ryO5lBI9",
+	"vC+fKtBTih3pen/k1L0fMFSoI2zbYRSvbE8mVLzHbolURz9SuqdgOumuAkmU4i8rdat6z20G+WES+rPP",
+	"+chheg06NV8Ook+PTf9MuShw0ZJDrvR3l1J9PfB5Rfv3ewS9XFVNFMKhcbsLQHKoSKCb+h3vN3qRU/8B",
+	"Iffqm7pnS8srOh0lJpLvRY6Hym8yBQlOv9vhA9upuQ963Td+sr7B0cUU5b/kJqCrmZdnhrWpafMs/EqT",
+	"Ed6nCwrOezKdAFc+fSg7ab95hjdk9skd17YznE5UKvfVQ+oPwqslMi5yb0kfUof1/sly7KUES9W9Tzxr",
+	"jYytWQ2/l9Z6jQ3+ko4dUmUdWqvECd/WxzWnNNFMUrfX7JU6qQi/NiqP65avfARNqMzShu1T8JLEU4ep",
+	"oL2bUS3Vt7Dke1WoEkDaNH2BnK7gIl23MKCA0zzexPnfLYyNytQk1imP37lMcDeTJcHgaObDPYw9zUcf",
+	"oSJKY+gsJ0e62In6zaNE8lwLvcEaPpHLETzX98fon2O4Z73ZAv0F/aPMxp+qNTs0I5Hdl2LF0z2dkKY0",
+	"eirRHkQZfc3y3Pqg5qdZCtzAqpdmLhU2RFPbkdWLEg5F2r3CsjBbcN7iPPA4VTyRwykOJFaQp02+s6bS",
+	"fXz330yVHTzO3r9O0vaMttHI5EWMTEfI2sY85tADC5eOHwQEN80AVAJSJDDE8DhIqk4KIge10DuJSDn7",
+	"+4X2oA+Oy3HGoTbPTEym/3hbuudd9O94l5N67TnO48l6vwq64vMYRh0iYSuu5fX0id+Qhp4xf/gNe8MO",
+	"Co9eWF31R+c9J07g2YSpJ5Zzj2EgMs3jZ4X0FPjZtPSzSwWYwKkpLPLG66PaT7BMOOxE34YtFH5vwSFz",
+	"HL7m/oKz6jgSyYKQXo+lz++p0GIItwCT8hFyB3UXMtRInRP8MBkEgdYKLHZAgW1eacC48ngMyjqlfbGm",
+	"iTD92U0cOYRrXBKLzE+gvwTS7bqcZ8pLz9P1JdL4rw0qTaQmz1Kmaoo7ayEW8iz5vGYzMo94pJPSyc4f",
+	"TAjKSVzMe9UpPvECPoZTzaWPD1v6KCibrrDU69Z9UtrSH5ac0xG/rJfqxE724I4a9qpCGe1/V7dLzo+V",
+	"eoCfe8c/sHc8J+/k5tzNz+fKlflblYXlr+bKleW52ZtK7gk9u8YKqbvOmk8TaC3HDdaJx9OAzRPvIhNX",
+	"YmIrnv1ENr0kWcL2h2zX/NYQSfJ4wsS1HzIogE9LNdXn7eMyOGfaRXjM4PeYFkX1jn1Wtgc/bDE0o+fR",
+	"0zwpC0Db/rrd6NFJ711sckcveTRDSk5V0Zjl/OjU5DNTVRfEXIYQ5V6zztRqXBor+74LkJUB8RzmAJfh",
+	"0bfMxGheJB7/5Cfj/q/rxexhldmz+RR06wsSlJt1onPsD+qwh1mcfrv8s7/6dIuL6CmDL3ol4UPJ5/mM",
+	"Zevs0Wr68JjDp8ZZOhLUatiOvmU8I40Lci7LT8LGKiwi/gjrY3mSCXhcSgkFF7ffSHDDdevFkhcXXbf+",
+	"aactgtpfET7WK2bJum/ZdWulLn3aTz5j4oGXtQ+cPhuJjvH2F05xZPB64T6vikK4LdB4QJ2BT/UuhPNM",
+	"yDOZCQli7g2DDmkB5wENrkAyZB4XArjOHA3zD2mMU2R0+sOzxzG7KEoG/AqWK0osELrz+VWD1sQa2FgE",
+	"JsrgNV4Lx6Oo2c5USn8JUx9CIWXg4hVMTKwwUkxN9q1J6h+UouV/wr2kcGtH1M2UkYkMZRH6yvBdjPjO",
+	"Ly2MSams1FdHyUmZFw8ejSyfRLu009dWsyh8FtaduvpwyFmZEldZZV/C5Cnrc4/Rjy8jdqCmwiZ6rmWe",
+	"HS0zD/A4p3RB47bO5dOF5YNHVqy6xbK+M70Q6dLp7FwoBCoD1wmTaTzOlOj20cHSsh9ZMJTKQky66hRs",
+	"d3CUUITCIx01eBYXumZY7TEW0CMThsK2DethpUbu23Ckxg2Q1wcMX/sxCOv96Fmi3xV7DK8npqL7+xiK",
+	"35SbgrXzevmpsCVSvwO6k/QGH8SgGHA3GMt5A8uHeFdeHXJZbPGo00jAx8bxaEHOYzsNZdP3NRGqsI0L",
+	"471xUrEpXRKJskVKLonoIjJF00wde6O5AX+ngECHtmH8BzxLlqKXJTuu5iWzBm5FjWH179BiLy/cxpRt",
+	"+9IDfabqoCUWD4pmm4a/T4DxqJDfetSGs2KEqKftXIJ+SAnKBBAA4m2L9icAw5CAzIkTe7txu4Z2UnJk",
+	"2saJ8Pqh0VME5clWnwS0+5A/0cA8kR6OfgBzi56DRY5YHggYiEAvdNv3sCtFF3Ez4osVPc9IaNZkez+B",
+	"pjFMWnLQ/a6+q/++CDlzmr8Lu/Hqo6ei/agh48eL1PRxg0Lew+3mKIuK0oyi7mrcehhFGyiyryGszbqc",
+	"wtvfUIs4eoGrwOzFLprRHOweKMMwo1kvJcgV6cDLAM4nT1Ausf1aZNs1TCxEUwVwSWmq+s3c/JdfLQPk",
+	"Tr9xjSItHf4Wt2sAirR1Ujij10NWpZ8xfbGUL2DlFSanhFtpGnzhnJndmJtdWq7cWJi9Pnc9+9VSmAub",
+	"WKtHBT5i/ix6pFsXR1ZUeDqIgpLmgPoFwxzLRFGmZ4JCryUGXC4luqSPum+dBoiw6aza9TqlxWRWTc6o",
+	"zn6CTn33DeZXe4jCHfmEj65slT0zo8BHXXbhpsWsSRjrjteCrr7PuTA+i5pX1t3mek9RFvYxOIT5/jD4",
+	"qyPwATzW7k2+bE5kCDHzmVGxS0nbhz/Ar1u9wlVLdesjq0iir6jbFh37c7PUIF4VfvezK8Nl5E5NF47x",
+	"LN2YvcYmUSVZ4W8aT45ehgfCLQCgvV2mnMp+h/O6oJMLy9APXmprA/GqPoOc2mdQ5iPvyQHE1YAttaWr",
+	"m2yYmHf3HKvhr7vBWM1eXc0xFv7KUiKOe/qPGPg1Qpq3ou/FrMCsAEbTvmpgolQcrYGsJ96fk/WSwmRr",
+	"hO4MD8IDjnCP1goNeEQvDdyoyn3i+eiiydCz2Tqv02UO046XN5dR4GtuP8pVb5S6wq1sKL10CupApT3Z",
+	"tYsqrWam9MxmyyytkFXXI0OsczpvnSdawVR0kXn9Lfkm98rY5adKJVnxXyXUM/YIk03gNEJiRecK90Zf",
+	"hEpvNCpInWg34V8XlxtqjD6ExIC48T7wEuYxRnUUW59sg+4iTRQUuQS2mWB9gl/vp1hXcWUnsAK5Gjvp",
+	"vpRabCLje89COS9Fb75kCKKtR0AVbatRw6Ui4qmp9gIUkYlUCKhkZihiMP0PjRsh8xWEYK6IsqgrMHOn",
+	"klEGnslqks+ZTD5n8lTqJ5HAGe7ZOHgBuX30DB5Fr0AqSr5unTbPfBzp0r+PsWqJexU77EqzXBahFiVT",
+	"lQaPV9Id9ieshj12j2zmqEf/hVFvbJ1ixBdvRrgxo+fhAcuYfisG5CRl0OdJnlnUrTqonlOf7Y4EcgOv",
+	"foV5NCK1hj8wegEuUd6uJH41ui73mZbW0vfwCPc5DrzSaZ4CJ+8Ldc4QGQkdPlPWs+Zp9Nvo2bgBUZk3",
+	"GU1dcToCax6AprPpwqzyvOBDlr/1a7qZsw37F2RzGBVQ1XKytYjsKqyE2B+u9mkY0DCrYVfYwc6Iv0uY",
+	"nwI9m1ZvvGO5cW3jV2Ozi/NjSNOUb6rqEWjw1Q8MW990M8U6lBcWzKzht4FaM6zd0vHpx97+LAcpWTtx",
+	"pRc4g8yRL7AANVOCbpdOlZVzNgb29zFcy3egAELBT1ztcxTtZF3ql6cvgv5SvElKMmhoAf8QQUOzRxjx",
+	"9+xYbYuuXFlUkHtK0RvF91mSTMDAdJJpwiP33Xt5+TQ/wDGhqAdxa9yY9+YLFfRBPMXIF66hxXLU1RZM",
+	"VPq9PIPcvozU+cfh+UMV6QAxaj1bsuvYT/RUbGEIBQoYJu4aYVc5X92stunuPeTNJykM+AKVF/YjDMJO",
+	"Yj3R83OBcC4QRiMQJEYMrFRm9dn9u3bzpYDso8sOpCBHlMb2a8bTB8zXTqjw52snsOungeZyxlGfkg5X",
+	"AY2pdESeml6e/PnMJR4zOqXou+hmXKA8icWraKg+sOvSwEvqwKKiNXHIC+YjUt9NfOR1MXi2jsIg0bgu",
+	"XZSeLfQERRvOlb+JT8ZUaFNI0v05w0WkYz0Mi5Orp4jEGSNznqUqLjkSaBZD7VHyGz8aXLA+5VVO8LHD",
+	"WmU/xgT/rGoArXqubRuYDhTnia4Vl9krGXbLf8I+gPbAlwjkl/EI0DlxaLCt28meMHQHTyX8SdlhxZ1q",
+	"MmAKediwPcIg4DMskc9hoUOYIECpyqpVDVwPkpukt3LmOjU2dSWLueb2alUfXmQXgNbQplcuaaDiJOZ+",
+	"bpOWUQl+5DQ5vo089RNkl8qqlLeeSoLdwDuWQCZiZWi9MKquqBGJufskN8iZ3PIT3LZe/I5ekIK9CF6h",
+	"L8XA/6GxyUGpzpAcOkpfGGacsfQalaucQyydosHWw1/Hyqqwwpj3inhOI8UcswEkHW0rKwHTZgbdCgvO",
+	"XDG5RoKyyOfPte++FCOHte6SkWl2GHZgvUqQaLGsxIlEY+EdGq3OqKxiNoXMn4hDU7xvY46vWRIdeFlX",
+	"6LvmAAC+/7h2Yjp9+0RTru8qJl1Bm22w1Gmpc+3SuutprTZhhuX7MCFfgrWwZnlksSObfWCA/xqqPKLH",
+	"WsflAKoHt8wGyKL+q9T5dbH8TwJBYyDbLLu6IV2POjU5efGjwmNGYDRtn0M8vaZRp/efCzUXrncvk2ix",
+	"/E+Qevk6PBAT0UuaQk2ws5l6g1j3xigsek+mvkisezfowFP02A3PoIh1rzTzUxP+SHivLlPv1dQ0b0WW",
+	"70oqzG3ghT0hMhbLZlpcC0wbWlAdvRJ1Y4n0muhpjN23r6oKWs4hlq6ZFWsyzqruunDewHLuiAI8qm2g",
+	"QbrPu3d2Wc7LaxTFiHpiSq2mMwBA2iJYUzL1qnsG7oXUUq0/T9kQ/i3YyZh6xYpPeGYPIlGodf+t8/z1",
+	"k3clHcUXjQNSAi+PSz4zL0+6NH+xjLA1SpeMHiA2hb1OvRCKePYLTIinYCmoGUryGIMe0mVUZf8oC2Qj",
+	"24U0NDhRIn1RBb65MlBYO/mUAQGKBoQgymQkpwQtlKDtYH4bLeB/n5tTzMOS3qwCBD5HJzp3tpyQs0VB",
+	"KcpJi+oTviiX9XsEg2Vj9kbDqgY9Ve8yGz+Pw4dSwE/D5pe7IE4P1+vQTDz+UuLxk9mPv5zx+C/sh0bd",
+	"XbMdIEZSHtnButuk5eeNulUlELCdmRq5eyGxoxrnQq64003yUREETbA7YkRX3vIveopZtUcMVUO5VKLf",
+	"fh+yT6WKfsZFNGoG79HbEk4avqaA02JlQYgEgRn/HbzxcYc6afE7H5H2+wPA9R7z3m6ImwJLFbAoHK+9",
+	"y+ts8uC45PqFQXKAfBLM+7OiDUmWYgsuFKGjfAYVhwikgpeiIte8fIZtShikGEimwoeikHvE1NNEqngA",
+	"HZk+84inisrY3PQJ0Xf0NNE1TDRix6Hg8+OwFawoVXIARrvR98w1mDqbLUNqVKqWtSYL398qQWNm6ES7",
+	"8e/2DceNW9PmJqsuSVs40vY22s3VVlQW7XKT7kiT8Y5CwH6J/qiZNh6wC6XlYaFKnwtqRct73R24qG2z",
+	"M4ALIybTqZgd8uGiE0HxCx0Q+I7ADPMLd5loqFVWNuMiZY1FU7Rdks6kyTla6iIeZcohvfDknDp6Rl0M",
+	"0Xb0QpYptLJLqAXpbK4UIEtMMz3zTGvxsvONvjcu2WcBxEymL0+tdxob11uyEtlGHGn/IdzjwXXRGyEz",
+	"F+jF6VtUItWY2wdxMF1qX8UMrK6mtdWF6DeIUsNRxBAskdUS+Rc/XPa0KKn6R8+jjtWpvyvpHKzOk++P",
+	"zOa5A3xALemeXa/7OQrSHxOtblhYiukze5Tr4J/UIQ/i5ar87w7fsX2mY8TJafTG/wiH9YiXq77G7Du6",
+	"lzlaAU55CIWAL/p2qeZW/TGvWTJLa27pbnHZH5OtOCsdxP2PrzkVwZlPlNF58k6AqiNk8n+WTm7Sefch",
+	"mvPKLbnia3Xurjt77jqV5xVnxVvis0c8FQgL7rdM8QEOlj6QMkKUz78iVj1Ylz+ZrW3YjvzBTRJYpa27",
+	"W/93AHyv6YMupAEA",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
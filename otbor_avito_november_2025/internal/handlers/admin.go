@@ -0,0 +1,404 @@
+package handlers
+
+import (
+	"otbor_avito_november_2025/internal/api"
+	"otbor_avito_november_2025/internal/store"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *Handler) GetAdminImbalanceAlerts(ctx echo.Context, params api.GetAdminImbalanceAlertsParams) error {
+	factor, alerts, err := h.service.GetImbalanceAlerts(ctx.Request().Context(), params.Factor)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	apiAlerts := make([]api.ImbalanceAlert, len(alerts))
+	for i, alert := range alerts {
+		apiAlerts[i] = api.ImbalanceAlert{
+			TeamName:    alert.TeamName,
+			MeanLoad:    alert.MeanLoad,
+			MaxLoad:     alert.MaxLoad,
+			Overloaded:  convertMemberLoadsToAPI(alert.Overloaded),
+			Underloaded: convertMemberLoadsToAPI(alert.Underloaded),
+		}
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"factor": factor,
+		"alerts": apiAlerts,
+	})
+}
+
+func convertMemberLoadsToAPI(loads []store.MemberOpenReviews) []api.MemberLoad {
+	result := make([]api.MemberLoad, len(loads))
+	for i, load := range loads {
+		result[i] = api.MemberLoad{
+			UserId:      load.UserID,
+			Username:    load.Username,
+			OpenReviews: load.OpenReviews,
+		}
+	}
+	return result
+}
+
+func (h *Handler) GetAdminFairness(ctx echo.Context, params api.GetAdminFairnessParams) error {
+	fairness, err := h.service.GetOrgFairness(ctx.Request().Context(), params.Since)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"since":            fairness.Since,
+		"users":            fairness.Users,
+		"assignments":      fairness.Assignments,
+		"mean_assignments": fairness.MeanAssignments,
+		"gini":             fairness.Gini,
+		"over_assigned":    convertAssignmentCountsToAPI(fairness.OverAssigned),
+		"under_assigned":   convertAssignmentCountsToAPI(fairness.UnderAssigned),
+	})
+}
+
+func convertAssignmentCountsToAPI(counts []store.UserAssignmentCount) []api.UserAssignmentCount {
+	result := make([]api.UserAssignmentCount, len(counts))
+	for i, count := range counts {
+		result[i] = api.UserAssignmentCount{
+			TeamName:    count.TeamName,
+			UserId:      count.UserID,
+			Username:    count.Username,
+			Assignments: count.Assignments,
+		}
+	}
+	return result
+}
+
+func (h *Handler) GetAdminOldestPending(ctx echo.Context, params api.GetAdminOldestPendingParams) error {
+	prs, err := h.service.GetOldestPendingPRs(ctx.Request().Context(), params.Limit)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	apiPRs := make([]api.PullRequest, len(prs))
+	for i, pr := range prs {
+		apiPRs[i] = convertPullRequestToAPI(pr)
+	}
+
+	filtered, err := filterFields(apiPRs, params.Fields, params.Strict)
+	if err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", err.Error()))
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"pull_requests": filtered,
+	})
+}
+
+func (h *Handler) GetAdminStrategyOutcomes(ctx echo.Context, params api.GetAdminStrategyOutcomesParams) error {
+	outcomes, err := h.service.GetStrategyOutcomes(ctx.Request().Context(), params.Since)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	strategies := make([]api.StrategyOutcome, len(outcomes.Strategies))
+	for i, outcome := range outcomes.Strategies {
+		strategies[i] = api.StrategyOutcome{
+			Strategy:              outcome.Strategy,
+			Assignments:           outcome.Assignments,
+			PullRequests:          outcome.PullRequests,
+			LoadVariance:          outcome.LoadVariance,
+			AvgFirstReviewSeconds: outcome.AvgFirstReviewSeconds,
+		}
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"since":      outcomes.Since,
+		"strategies": strategies,
+	})
+}
+
+func (h *Handler) PostAdminIntegrityPrStatus(ctx echo.Context, params api.PostAdminIntegrityPrStatusParams) error {
+	dryRun := params.DryRun != nil && *params.DryRun
+
+	fixes, err := h.service.FixPRStatusInconsistencies(ctx.Request().Context(), dryRun)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	apiFixes := make([]api.PRStatusFix, len(fixes))
+	for i, fix := range fixes {
+		apiFixes[i] = api.PRStatusFix{
+			PullRequestId:    fix.PullRequestID,
+			Status:           string(fix.Status),
+			PreviousMergedAt: fix.PreviousMergedAt,
+			MergedAt:         fix.MergedAt,
+		}
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"dry_run":       dryRun,
+		"pull_requests": apiFixes,
+	})
+}
+
+func (h *Handler) GetAdminIntegritySelfReview(ctx echo.Context) error {
+	reviews, err := h.service.GetSelfReviews(ctx.Request().Context())
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"pull_requests": convertSelfReviewsToAPI(reviews),
+	})
+}
+
+func (h *Handler) PostAdminIntegritySelfReview(ctx echo.Context) error {
+	reviews, err := h.service.RemoveSelfReviews(ctx.Request().Context())
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"pull_requests": convertSelfReviewsToAPI(reviews),
+	})
+}
+
+func convertSelfReviewsToAPI(reviews []store.SelfReview) []api.SelfReview {
+	apiReviews := make([]api.SelfReview, len(reviews))
+	for i, review := range reviews {
+		apiReviews[i] = api.SelfReview{
+			PullRequestId: review.PullRequestID,
+			AuthorId:      review.AuthorID,
+			Status:        string(review.Status),
+			CreatedAt:     review.CreatedAt,
+			AssignedAt:    review.AssignedAt,
+		}
+	}
+	return apiReviews
+}
+
+func (h *Handler) GetAdminAssignmentQueue(ctx echo.Context) error {
+	queue, err := h.service.GetAssignmentQueue(ctx.Request().Context())
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	pending := make([]api.PendingAssignment, len(queue))
+	for i, p := range queue {
+		pending[i] = api.PendingAssignment{
+			PullRequestId: p.PullRequestID,
+			TeamName:      p.TeamName,
+			Attempts:      p.Attempts,
+			NextAttemptAt: p.NextAttemptAt,
+			ExpiresAt:     p.ExpiresAt,
+			CreatedAt:     p.CreatedAt,
+		}
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"pending": pending,
+	})
+}
+
+func (h *Handler) PostAdminAssignmentQueueRetry(ctx echo.Context) error {
+	var req api.PostAdminAssignmentQueueRetryJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	pr, err := h.service.RetryPendingAssignment(ctx.Request().Context(), prKey(req.TeamName, req.PullRequestId))
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"pr":                 convertPullRequestToAPI(pr),
+		"assignment_pending": pr.AssignmentPending,
+	})
+}
+
+func (h *Handler) PostAdminBackfillMembership(ctx echo.Context) error {
+	users, err := h.service.BackfillMembership(ctx.Request().Context())
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	created := make([]api.PlaceholderUser, len(users))
+	for i, user := range users {
+		created[i] = api.PlaceholderUser{
+			UserId:       user.UserID,
+			TeamName:     user.TeamName,
+			PullRequests: user.PullRequests,
+		}
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"created": created,
+	})
+}
+
+func (h *Handler) GetAdminFlags(ctx echo.Context) error {
+	states := h.service.FeatureFlags(ctx.Request().Context())
+
+	flags := make([]api.FeatureFlag, len(states))
+	for i, state := range states {
+		flags[i] = api.FeatureFlag{
+			Name:    state.Name,
+			Enabled: state.Enabled,
+			Source:  state.Source,
+		}
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"flags": flags,
+	})
+}
+
+func (h *Handler) GetAdminHighChurnPrs(ctx echo.Context, params api.GetAdminHighChurnPrsParams) error {
+	prs, total, err := h.service.GetHighChurnPRs(ctx.Request().Context(), params.MinReassigns, params.Limit, params.Offset)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	apiPRs := make([]api.HighChurnPR, len(prs))
+	for i, pr := range prs {
+		apiPRs[i] = api.HighChurnPR{
+			PullRequest: api.PullRequestShort{
+				PullRequestId:   pr.PullRequest.PullRequestID,
+				PullRequestName: pr.PullRequest.PullRequestName,
+				AuthorId:        pr.PullRequest.AuthorID,
+				Status:          api.PullRequestShortStatus(pr.PullRequest.Status),
+			},
+			Reassignments:     pr.Reassignments,
+			AssignedReviewers: pr.Reviewers,
+		}
+	}
+
+	filtered, err := filterFields(apiPRs, params.Fields, params.Strict)
+	if err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", err.Error()))
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"total":         total,
+		"pull_requests": filtered,
+	})
+}
+
+func (h *Handler) GetAdminInactiveAssignments(ctx echo.Context) error {
+	assignments, err := h.service.GetInactiveReviewerAssignments(ctx.Request().Context())
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	apiAssignments := make([]api.InactiveAssignment, len(assignments))
+	for i, a := range assignments {
+		apiAssignments[i] = api.InactiveAssignment{
+			PullRequest: api.PullRequestShort{
+				PullRequestId:   a.PullRequest.PullRequestID,
+				PullRequestName: a.PullRequest.PullRequestName,
+				AuthorId:        a.PullRequest.AuthorID,
+				Status:          api.PullRequestShortStatus(a.PullRequest.Status),
+			},
+			InactiveReviewers: a.InactiveReviewers,
+		}
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"pull_requests": apiAssignments,
+	})
+}
+
+func (h *Handler) GetAdminTeams(ctx echo.Context, params api.GetAdminTeamsParams) error {
+	teams, total, err := h.service.GetTeamsBySize(ctx.Request().Context(), params.MinMembers, params.MaxMembers, params.Limit, params.Offset)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	apiTeams := make([]api.TeamSize, len(teams))
+	for i, team := range teams {
+		apiTeams[i] = api.TeamSize{
+			TeamName: team.TeamName,
+			Members:  team.Members,
+		}
+	}
+
+	filtered, err := filterFields(apiTeams, params.Fields, params.Strict)
+	if err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", err.Error()))
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"total": total,
+		"teams": filtered,
+	})
+}
+
+func (h *Handler) GetAdminWebhooks(ctx echo.Context) error {
+	subs, err := h.service.GetWebhookSubscriptions(ctx.Request().Context())
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	apiSubs := make([]api.WebhookSubscription, len(subs))
+	for i := range subs {
+		apiSubs[i] = convertWebhookSubscriptionToAPI(&subs[i])
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"subscriptions": apiSubs,
+	})
+}
+
+func (h *Handler) PostAdminWebhooks(ctx echo.Context) error {
+	var req api.PostAdminWebhooksJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	sub, err := h.service.CreateWebhookSubscription(ctx.Request().Context(), req.Url, req.Secret, req.Events)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(201, map[string]interface{}{
+		"subscription": convertWebhookSubscriptionToAPI(sub),
+	})
+}
+
+func (h *Handler) PostAdminWebhooksDelete(ctx echo.Context) error {
+	var req api.PostAdminWebhooksDeleteJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	if err := h.service.DeleteWebhookSubscription(ctx.Request().Context(), req.Id); err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"id": req.Id,
+	})
+}
+
+func convertWebhookSubscriptionToAPI(sub *store.WebhookSubscription) api.WebhookSubscription {
+	return api.WebhookSubscription{
+		Id:        sub.ID,
+		Url:       sub.URL,
+		Events:    sub.Events,
+		CreatedAt: sub.CreatedAt,
+	}
+}
+
+func (h *Handler) PostAdminWebhooksIdTest(ctx echo.Context, id int64) error {
+	result, err := h.service.TestWebhookSubscription(ctx.Request().Context(), id)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, api.WebhookTestResult{
+		SubscriptionId: result.SubscriptionID,
+		StatusCode:     result.StatusCode,
+		LatencyMs:      result.Latency.Milliseconds(),
+		Error:          result.Error,
+	})
+}
@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+	"sync"
+
+	"otbor_avito_november_2025/internal/api"
+	"otbor_avito_november_2025/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	HeaderAPIKey = "X-API-Key"
+
+	ctxAdmin  = "auth.admin"
+	ctxUserID = "auth.user_id"
+)
+
+type AdminTokens struct {
+	mu     sync.RWMutex
+	tokens []string
+}
+
+func NewAdminTokens(raw string) *AdminTokens {
+	t := &AdminTokens{}
+	t.Set(raw)
+	return t
+}
+
+func (t *AdminTokens) Set(raw string) {
+	var tokens []string
+	for _, token := range strings.Split(raw, ",") {
+		if token = strings.TrimSpace(token); token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+
+	t.mu.Lock()
+	t.tokens = tokens
+	t.mu.Unlock()
+}
+
+func (t *AdminTokens) Len() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.tokens)
+}
+
+func (t *AdminTokens) Valid(token string) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	valid := false
+	for _, known := range t.tokens {
+		if subtle.ConstantTimeCompare([]byte(known), []byte(token)) == 1 {
+			valid = true
+		}
+	}
+	return valid
+}
+
+func AdminAuth(tokens *AdminTokens) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			token, ok := bearerToken(ctx.Request().Header.Get(echo.HeaderAuthorization))
+			if !ok || !tokens.Valid(token) {
+				ctx.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+				return ctx.JSON(401, createError("UNAUTHORIZED", "missing or invalid admin token"))
+			}
+			return next(ctx)
+		}
+	}
+}
+
+func Authenticate(tokens *AdminTokens, svc *service.Service) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if token, ok := bearerToken(ctx.Request().Header.Get(echo.HeaderAuthorization)); ok && tokens.Valid(token) {
+				ctx.Set(ctxAdmin, true)
+			}
+
+			if key := ctx.Request().Header.Get(HeaderAPIKey); key != "" {
+				userID, err := svc.ResolveAPIKey(ctx.Request().Context(), key)
+				if err != nil {
+					return handleServiceError(ctx, err)
+				}
+				ctx.Set(ctxUserID, userID)
+			}
+
+			return next(ctx)
+		}
+	}
+}
+
+func (h *Handler) authorizeUser(ctx echo.Context, userID string) error {
+	if admin, _ := ctx.Get(ctxAdmin).(bool); admin {
+		return nil
+	}
+	if actor, ok := ctx.Get(ctxUserID).(string); ok {
+		if actor != userID {
+			return service.ErrForbidden
+		}
+		return nil
+	}
+	if h.service.UserAPIKeysRequired(ctx.Request().Context()) {
+		return service.ErrUnauthenticated
+	}
+	return nil
+}
+
+func (h *Handler) authorizeKeyOwner(ctx echo.Context, userID string) error {
+	if admin, _ := ctx.Get(ctxAdmin).(bool); admin {
+		return nil
+	}
+	actor, ok := ctx.Get(ctxUserID).(string)
+	if !ok {
+		return service.ErrUnauthenticated
+	}
+	if actor != userID {
+		return service.ErrForbidden
+	}
+	return nil
+}
+
+func bearerToken(header string) (string, bool) {
+	const prefix = "Bearer "
+	if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return "", false
+	}
+	return strings.TrimSpace(header[len(prefix):]), true
+}
+
+type adminRouter struct {
+	*echo.Echo
+	admin      *echo.Group
+	middleware []echo.MiddlewareFunc
+}
+
+// NewAdminRouter wraps e so that every route under adminPathPrefix and every
+// route in adminOpsRoutes is registered behind the admin middleware m.
+func NewAdminRouter(e *echo.Echo, m ...echo.MiddlewareFunc) api.EchoRouter {
+	return &adminRouter{Echo: e, admin: e.Group(adminPathPrefix, m...), middleware: m}
+}
+
+func (r *adminRouter) add(method, path string, h echo.HandlerFunc, m []echo.MiddlewareFunc) *echo.Route {
+	if strings.HasPrefix(path, adminPathPrefix+"/") {
+		return r.admin.Add(method, strings.TrimPrefix(path, adminPathPrefix), h, m...)
+	}
+	if RequiresAdmin(method, path) {
+		m = append(append([]echo.MiddlewareFunc{}, r.middleware...), m...)
+	}
+	return r.Echo.Add(method, path, h, m...)
+}
+
+func (r *adminRouter) CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodConnect, path, h, m)
+}
+
+func (r *adminRouter) DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodDelete, path, h, m)
+}
+
+func (r *adminRouter) GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodGet, path, h, m)
+}
+
+func (r *adminRouter) HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodHead, path, h, m)
+}
+
+func (r *adminRouter) OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodOptions, path, h, m)
+}
+
+func (r *adminRouter) PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodPatch, path, h, m)
+}
+
+func (r *adminRouter) POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodPost, path, h, m)
+}
+
+func (r *adminRouter) PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodPut, path, h, m)
+}
+
+func (r *adminRouter) TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodTrace, path, h, m)
+}
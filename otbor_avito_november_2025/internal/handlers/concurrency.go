@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const concurrencyRetryAfter = "1"
+
+var concurrencyExempt = map[string]bool{
+	"/health":  true,
+	"/metrics": true,
+}
+
+type ConcurrencyLimits struct {
+	slots map[string]chan struct{}
+}
+
+func NewConcurrencyLimits(raw string) *ConcurrencyLimits {
+	l := &ConcurrencyLimits{slots: make(map[string]chan struct{})}
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		path, value, found := strings.Cut(entry, "=")
+		path = strings.TrimSpace(path)
+		limit, err := strconv.Atoi(strings.TrimSpace(value))
+		if !found || path == "" || err != nil || limit <= 0 {
+			log.Println("Ignoring invalid concurrency limit:", entry)
+			continue
+		}
+		if concurrencyExempt[path] {
+			log.Println("Ignoring concurrency limit for exempt path:", path)
+			continue
+		}
+		l.slots[path] = make(chan struct{}, limit)
+	}
+	return l
+}
+
+func (l *ConcurrencyLimits) Len() int {
+	return len(l.slots)
+}
+
+func ConcurrencyLimit(limits *ConcurrencyLimits) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			slots, ok := limits.slots[ctx.Path()]
+			if !ok {
+				return next(ctx)
+			}
+
+			select {
+			case slots <- struct{}{}:
+			default:
+				ctx.Response().Header().Set("Retry-After", concurrencyRetryAfter)
+				return ctx.JSON(503, createError("OVERLOADED", "too many concurrent requests to this endpoint"))
+			}
+			defer func() { <-slots }()
+			return next(ctx)
+		}
+	}
+}
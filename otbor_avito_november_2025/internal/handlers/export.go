@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"otbor_avito_november_2025/internal/api"
+	"otbor_avito_november_2025/internal/service"
+	"otbor_avito_november_2025/internal/store"
+
+	"github.com/labstack/echo/v4"
+)
+
+const exportFlushEvery = 500
+
+func (h *Handler) GetAdminReviewExport(ctx echo.Context, params api.GetAdminReviewExportParams) error {
+	format := api.Jsonl
+	if params.Format != nil {
+		format = *params.Format
+	}
+	if format != api.Csv && format != api.Jsonl {
+		return handleServiceError(ctx, service.ErrInvalidFormat)
+	}
+
+	resp := ctx.Response()
+	csvWriter := csv.NewWriter(resp)
+	encoder := json.NewEncoder(resp)
+
+	started := false
+	written := 0
+	begin := func() {
+		if started {
+			return
+		}
+		started = true
+		if format == api.Csv {
+			resp.Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+			resp.WriteHeader(http.StatusOK)
+			csvWriter.Write([]string{"pr_id", "reviewer_id", "review_state", "assigned_at", "reviewed_at"})
+			return
+		}
+		resp.Header().Set(echo.HeaderContentType, "application/x-ndjson")
+		resp.WriteHeader(http.StatusOK)
+	}
+
+	err := h.service.ExportReviews(ctx.Request().Context(), params.Since, params.Until, func(row store.ReviewExportRow) error {
+		begin()
+		if format == api.Csv {
+			reviewedAt := ""
+			if row.ReviewedAt != nil {
+				reviewedAt = row.ReviewedAt.Format(time.RFC3339)
+			}
+			csvWriter.Write([]string{row.PullRequestID, row.ReviewerID, row.ReviewState, row.AssignedAt.Format(time.RFC3339), reviewedAt})
+		} else if err := encoder.Encode(row); err != nil {
+			return err
+		}
+
+		written++
+		if written%exportFlushEvery == 0 {
+			csvWriter.Flush()
+			resp.Flush()
+		}
+		return csvWriter.Error()
+	})
+	if err != nil {
+		if !started {
+			return handleServiceError(ctx, err)
+		}
+		log.Println("Review export aborted:", err)
+		return nil
+	}
+
+	begin()
+	csvWriter.Flush()
+	resp.Flush()
+	return nil
+}
+
+func (h *Handler) GetAdminReviewMatrixStream(ctx echo.Context) error {
+	reqCtx := ctx.Request().Context()
+	resp := ctx.Response()
+	encoder := json.NewEncoder(resp)
+
+	started := false
+	written := 0
+	begin := func() {
+		if started {
+			return
+		}
+		started = true
+		resp.Header().Set(echo.HeaderContentType, "application/x-ndjson")
+		resp.WriteHeader(http.StatusOK)
+	}
+
+	err := h.service.StreamReviewMatrix(reqCtx, func(row store.ReviewMatrixRow) error {
+		if err := reqCtx.Err(); err != nil {
+			return err
+		}
+		begin()
+		if err := encoder.Encode(row); err != nil {
+			return err
+		}
+
+		written++
+		if written%exportFlushEvery == 0 {
+			resp.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		if !started {
+			return handleServiceError(ctx, err)
+		}
+		log.Println("Review matrix stream aborted:", err)
+		return nil
+	}
+
+	begin()
+	resp.Flush()
+	return nil
+}
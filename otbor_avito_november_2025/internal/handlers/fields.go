@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+func filterFields(value interface{}, fields *string, strict *bool) (interface{}, error) {
+	if fields == nil || strings.TrimSpace(*fields) == "" {
+		return value, nil
+	}
+
+	known := jsonFieldNames(reflect.TypeOf(value))
+	keep := make(map[string]bool)
+	for _, name := range strings.Split(*fields, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !known[name] {
+			if strict != nil && *strict {
+				return nil, fmt.Errorf("unknown field: %s", name)
+			}
+			continue
+		}
+		keep[name] = true
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	switch v := decoded.(type) {
+	case []interface{}:
+		for i, item := range v {
+			v[i] = pickFields(item, keep)
+		}
+		return v, nil
+	default:
+		return pickFields(v, keep), nil
+	}
+}
+
+func pickFields(value interface{}, keep map[string]bool) interface{} {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	for name := range object {
+		if !keep[name] {
+			delete(object, name)
+		}
+	}
+	return object
+}
+
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	for t != nil && (t.Kind() == reflect.Slice || t.Kind() == reflect.Ptr) {
+		t = t.Elem()
+	}
+
+	names := make(map[string]bool)
+	if t == nil || t.Kind() != reflect.Struct {
+		return names
+	}
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			names[name] = true
+		}
+	}
+	return names
+}
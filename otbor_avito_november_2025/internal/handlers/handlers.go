@@ -1,234 +1,1101 @@
-package handlers
-
-import (
-	"otbor_avito_november_2025/internal/api"
-	"otbor_avito_november_2025/internal/service"
-	"otbor_avito_november_2025/internal/store"
-
-	"github.com/labstack/echo/v4"
-)
-
-type Handler struct {
-	service *service.Service
-}
-
-func NewHandler(service *service.Service) *Handler {
-	return &Handler{service: service}
-}
-
-var _ api.ServerInterface = (*Handler)(nil)
-
-func (h *Handler) PostPullRequestCreate(ctx echo.Context) error {
-	var req api.PostPullRequestCreateJSONRequestBody
-	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
-	}
-
-	pr, err := h.service.CreatePR(ctx.Request().Context(), req.PullRequestId, req.PullRequestName, req.AuthorId)
-	if err != nil {
-		return handleServiceError(ctx, err)
-	}
-
-	return ctx.JSON(201, map[string]interface{}{
-		"pr": convertPullRequestToAPI(pr),
-	})
-}
-
-func (h *Handler) PostPullRequestMerge(ctx echo.Context) error {
-	var req api.PostPullRequestMergeJSONRequestBody
-	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
-	}
-
-	pr, err := h.service.MergePR(ctx.Request().Context(), req.PullRequestId)
-	if err != nil {
-		return handleServiceError(ctx, err)
-	}
-
-	return ctx.JSON(200, map[string]interface{}{
-		"pr": convertPullRequestToAPI(pr),
-	})
-}
-
-func (h *Handler) PostPullRequestReassign(ctx echo.Context) error {
-	var req api.PostPullRequestReassignJSONRequestBody
-	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
-	}
-
-	pr, replacedBy, err := h.service.ReassignReviewer(ctx.Request().Context(), req.PullRequestId, req.OldUserId)
-	if err != nil {
-		return handleServiceError(ctx, err)
-	}
-
-	return ctx.JSON(200, map[string]interface{}{
-		"pr":          convertPullRequestToAPI(pr),
-		"replaced_by": replacedBy,
-	})
-}
-
-func (h *Handler) PostTeamAdd(ctx echo.Context) error {
-	var req api.Team
-	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
-	}
-
-	members := make([]service.TeamMember, len(req.Members))
-	for i, m := range req.Members {
-		members[i] = service.TeamMember{
-			UserID:   m.UserId,
-			Username: m.Username,
-			IsActive: m.IsActive,
-		}
-	}
-
-	team, err := h.service.CreateOrUpdateTeam(ctx.Request().Context(), req.TeamName, members)
-	if err != nil {
-		if err == service.ErrTeamExists {
-			return ctx.JSON(400, createError("TEAM_EXISTS", err.Error()))
-		}
-		return ctx.JSON(500, createError("INTERNAL_ERROR", err.Error()))
-	}
-
-	_, teamMembers, err := h.service.GetTeam(ctx.Request().Context(), req.TeamName)
-	if err != nil {
-		return ctx.JSON(500, createError("INTERNAL_ERROR", err.Error()))
-	}
-
-	apiMembers := make([]api.TeamMember, len(teamMembers))
-	for i, m := range teamMembers {
-		apiMembers[i] = api.TeamMember{
-			UserId:   m.UserID,
-			Username: m.Username,
-			IsActive: m.IsActive,
-		}
-	}
-
-	response := api.Team{
-		TeamName: team.Name,
-		Members:  apiMembers,
-	}
-
-	return ctx.JSON(201, map[string]interface{}{
-		"team": response,
-	})
-}
-
-func (h *Handler) GetTeamGet(ctx echo.Context, params api.GetTeamGetParams) error {
-	team, members, err := h.service.GetTeam(ctx.Request().Context(), params.TeamName)
-	if err != nil {
-		return ctx.JSON(404, createError("NOT_FOUND", err.Error()))
-	}
-
-	apiMembers := make([]api.TeamMember, len(members))
-	for i, m := range members {
-		apiMembers[i] = api.TeamMember{
-			UserId:   m.UserID,
-			Username: m.Username,
-			IsActive: m.IsActive,
-		}
-	}
-
-	response := api.Team{
-		TeamName: team.Name,
-		Members:  apiMembers,
-	}
-
-	return ctx.JSON(200, response)
-}
-
-func (h *Handler) GetUsersGetReview(ctx echo.Context, params api.GetUsersGetReviewParams) error {
-	prs, err := h.service.GetUserAssignedPRs(ctx.Request().Context(), params.UserId)
-	if err != nil {
-		return ctx.JSON(404, createError("NOT_FOUND", err.Error()))
-	}
-
-	shortPRs := make([]api.PullRequestShort, len(prs))
-	for i, pr := range prs {
-		shortPRs[i] = api.PullRequestShort{
-			PullRequestId:   pr.PullRequest.PullRequestID,
-			PullRequestName: pr.PullRequest.PullRequestName,
-			AuthorId:        pr.PullRequest.AuthorID,
-			Status:          api.PullRequestShortStatus(pr.PullRequest.Status),
-		}
-	}
-
-	return ctx.JSON(200, map[string]interface{}{
-		"user_id":       params.UserId,
-		"pull_requests": shortPRs,
-	})
-}
-
-func (h *Handler) PostUsersSetIsActive(ctx echo.Context) error {
-	var req api.PostUsersSetIsActiveJSONRequestBody
-	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
-	}
-
-	user, err := h.service.SetUserActive(ctx.Request().Context(), req.UserId, req.IsActive)
-	if err != nil {
-		return ctx.JSON(404, createError("NOT_FOUND", err.Error()))
-	}
-
-	response := api.User{
-		UserId:   user.UserID,
-		Username: user.Username,
-		TeamName: user.TeamName,
-		IsActive: user.IsActive,
-	}
-
-	return ctx.JSON(200, map[string]interface{}{
-		"user": response,
-	})
-}
-
-func createError(code, message string) api.ErrorResponse {
-	return api.ErrorResponse{
-		Error: struct {
-			Code    api.ErrorResponseErrorCode `json:"code"`
-			Message string                     `json:"message"`
-		}{
-			Code:    api.ErrorResponseErrorCode(code),
-			Message: message,
-		},
-	}
-}
-
-func handleServiceError(ctx echo.Context, err error) error {
-	switch err {
-	case service.ErrPRExists:
-		return ctx.JSON(409, createError("PR_EXISTS", err.Error()))
-	case service.ErrPRMerged:
-		return ctx.JSON(409, createError("PR_MERGED", err.Error()))
-	case service.ErrNotAssigned:
-		return ctx.JSON(409, createError("NOT_ASSIGNED", err.Error()))
-	case service.ErrNoCandidate:
-		return ctx.JSON(409, createError("NO_CANDIDATE", err.Error()))
-	case service.ErrNotFound:
-		return ctx.JSON(404, createError("NOT_FOUND", err.Error()))
-	default:
-		return ctx.JSON(500, createError("INTERNAL_ERROR", err.Error()))
-	}
-}
-
-func convertPullRequestToAPI(pr *service.PullRequestWithReviewers) api.PullRequest {
-	assignedReviewers := getUserIDs(pr.AssignedReviewers)
-
-	return api.PullRequest{
-		PullRequestId:     pr.PullRequest.PullRequestID,
-		PullRequestName:   pr.PullRequest.PullRequestName,
-		AuthorId:          pr.PullRequest.AuthorID,
-		Status:            api.PullRequestStatus(pr.PullRequest.Status),
-		AssignedReviewers: assignedReviewers,
-		CreatedAt:         &pr.PullRequest.CreatedAt,
-		MergedAt:          pr.PullRequest.MergedAt,
-	}
-}
-
-func getUserIDs(users []store.User) []string {
-	ids := make([]string, len(users))
-	for i, user := range users {
-		ids[i] = user.UserID
-	}
-	return ids
-}
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"otbor_avito_november_2025/internal/api"
+	"otbor_avito_november_2025/internal/service"
+	"otbor_avito_november_2025/internal/store"
+
+	"github.com/labstack/echo/v4"
+)
+
+type Handler struct {
+	service *service.Service
+}
+
+func NewHandler(service *service.Service) *Handler {
+	return &Handler{service: service}
+}
+
+var _ api.ServerInterface = (*Handler)(nil)
+
+func (h *Handler) PostPullRequestCreate(ctx echo.Context, params api.PostPullRequestCreateParams) error {
+	if params.Expand != nil && *params.Expand != service.ExpandReviewers {
+		return handleServiceError(ctx, service.ErrInvalidPRExpand)
+	}
+
+	var req api.PostPullRequestCreateJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	opts := service.CreatePROptions{
+		ReviewDeadline:       req.ReviewDeadline,
+		RelatedPullRequestID: req.RelatedPullRequestId,
+	}
+	if req.Paths != nil {
+		opts.Paths = *req.Paths
+	}
+	if req.RequiredSkills != nil {
+		opts.RequiredSkills = *req.RequiredSkills
+	}
+	if req.Priority != nil {
+		opts.Priority = string(*req.Priority)
+	}
+
+	pr, err := h.service.CreatePR(ctx.Request().Context(), req.PullRequestId, req.PullRequestName, req.AuthorId, opts)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	resp := map[string]interface{}{
+		"pr":                 convertPullRequestToAPI(pr),
+		"required_reviewers": pr.RequiredReviewers,
+	}
+	if req.RelatedPullRequestId != nil {
+		resp["related_reviewers_fallback"] = pr.RelatedFallback
+	}
+	if pr.Suppressed {
+		resp["assignment_suppressed"] = true
+	}
+	if pr.QuotaExceeded {
+		resp["quota_exceeded"] = true
+	}
+	if pr.AssignmentPending {
+		resp["assignment_pending"] = true
+	}
+	if len(opts.RequiredSkills) > 0 {
+		resp["skill_fallback"] = pr.SkillFallback
+	}
+	if pr.FastResponders {
+		resp["fast_responders"] = true
+	}
+	if params.Expand != nil {
+		reviewers := make([]api.AssignedReviewer, len(pr.AssignedReviewers))
+		for i, reviewer := range pr.AssignedReviewers {
+			reviewers[i] = api.AssignedReviewer{
+				UserId:           reviewer.UserID,
+				Username:         reviewer.Username,
+				LoadAtAssignment: pr.LoadAtAssignment[reviewer.UserID],
+			}
+		}
+		resp["reviewers"] = reviewers
+	}
+
+	setLocation(ctx, "/pull-request/get", "pull_request_id", pr.PullRequest.PullRequestID)
+	return ctx.JSON(201, resp)
+}
+
+func (h *Handler) PostPullRequestMerge(ctx echo.Context) error {
+	var req api.PostPullRequestMergeJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	pr, err := h.service.MergePR(ctx.Request().Context(), prKey(req.TeamName, req.PullRequestId))
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"pr": convertPullRequestToAPI(pr),
+	})
+}
+
+func (h *Handler) PostPullRequestClose(ctx echo.Context) error {
+	var req api.PostPullRequestCloseJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	pr, err := h.service.ClosePR(ctx.Request().Context(), prKey(req.TeamName, req.PullRequestId))
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"pr": convertPullRequestToAPI(pr),
+	})
+}
+
+func (h *Handler) PatchPullRequest(ctx echo.Context) error {
+	var req api.PatchPullRequestJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	admin, _ := ctx.Get(ctxAdmin).(bool)
+
+	pr, err := h.service.UpdatePRName(ctx.Request().Context(), prKey(req.TeamName, req.PullRequestId), req.PullRequestName, admin)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"pr": convertPullRequestToAPI(pr),
+	})
+}
+
+func (h *Handler) PostPullRequestReassign(ctx echo.Context) error {
+	var req api.PostPullRequestReassignJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	var pr *service.PullRequestWithReviewers
+	var replacedBy string
+	var err error
+	if req.NewUserId != nil {
+		pr, replacedBy, err = h.service.ReassignReviewerTo(ctx.Request().Context(), prKey(req.TeamName, req.PullRequestId), req.OldUserId, *req.NewUserId)
+	} else {
+		pr, replacedBy, err = h.service.ReassignReviewer(ctx.Request().Context(), prKey(req.TeamName, req.PullRequestId), req.OldUserId)
+	}
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"pr":          convertPullRequestToAPI(pr),
+		"replaced_by": replacedBy,
+	})
+}
+
+func (h *Handler) PostTeamAdd(ctx echo.Context) error {
+	var req api.Team
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	members := make([]service.TeamMember, len(req.Members))
+	for i, m := range req.Members {
+		members[i] = service.TeamMember{
+			UserID:   m.UserId,
+			Username: m.Username,
+			IsActive: m.IsActive,
+		}
+	}
+
+	team, created, err := h.service.CreateOrUpdateTeam(ctx.Request().Context(), req.TeamName, members, req.RequiredReviewers, req.FallbackTeams)
+	if err != nil {
+		var memberErr *service.MemberValidationError
+		if errors.As(err, &memberErr) {
+			return ctx.JSON(422, createError("VALIDATION_ERROR", err.Error()))
+		}
+		return handleServiceError(ctx, err)
+	}
+
+	_, teamMembers, err := h.service.GetTeam(ctx.Request().Context(), req.TeamName)
+	if err != nil {
+		return ctx.JSON(500, createError("INTERNAL_ERROR", err.Error()))
+	}
+
+	apiMembers := make([]api.TeamMember, len(teamMembers))
+	for i, m := range teamMembers {
+		apiMembers[i] = api.TeamMember{
+			UserId:   m.UserID,
+			Username: m.Username,
+			IsActive: m.IsActive,
+		}
+	}
+
+	response := api.Team{
+		TeamName:          team.Name,
+		Members:           apiMembers,
+		RequiredReviewers: &team.RequiredReviewers,
+	}
+	if err := h.setFallbackTeams(ctx, &response); err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	if !created {
+		return ctx.JSON(200, map[string]interface{}{
+			"team": response,
+		})
+	}
+
+	setLocation(ctx, "/team/get", "team_name", team.Name)
+	return ctx.JSON(201, map[string]interface{}{
+		"team": response,
+	})
+}
+
+func (h *Handler) PostPullRequestAcknowledge(ctx echo.Context) error {
+	var req api.PostPullRequestAcknowledgeJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	if err := h.authorizeUser(ctx, req.UserId); err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	acks, err := h.service.AcknowledgeReview(ctx.Request().Context(), prKey(req.TeamName, req.PullRequestId), req.UserId)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"pull_request_id": req.PullRequestId,
+		"reviewers":       convertAcknowledgementsToAPI(acks),
+	})
+}
+
+func (h *Handler) GetPullRequestAcknowledgements(ctx echo.Context, params api.GetPullRequestAcknowledgementsParams) error {
+	acks, err := h.service.GetPRAcknowledgements(ctx.Request().Context(), prKey(params.TeamName, params.PullRequestId))
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"pull_request_id": params.PullRequestId,
+		"reviewers":       convertAcknowledgementsToAPI(acks),
+	})
+}
+
+func (h *Handler) PostPullRequestApprove(ctx echo.Context) error {
+	var req api.PostPullRequestApproveJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	if err := h.authorizeUser(ctx, req.UserId); err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	pr, err := h.service.ApprovePR(ctx.Request().Context(), prKey(req.TeamName, req.PullRequestId), req.UserId)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"pr": convertPullRequestToAPI(pr),
+	})
+}
+
+func convertAcknowledgementsToAPI(acks []store.ReviewerAcknowledgement) []api.ReviewerAcknowledgement {
+	result := make([]api.ReviewerAcknowledgement, len(acks))
+	for i, ack := range acks {
+		result[i] = api.ReviewerAcknowledgement{
+			UserId:         ack.UserID,
+			AcknowledgedAt: ack.AcknowledgedAt,
+		}
+	}
+	return result
+}
+
+func convertApprovalsToAPI(approvals []store.Approval) []api.Approval {
+	result := make([]api.Approval, len(approvals))
+	for i, approval := range approvals {
+		result[i] = api.Approval{
+			UserId:     approval.UserID,
+			ApprovedAt: approval.ApprovedAt,
+		}
+	}
+	return result
+}
+
+func (h *Handler) PostTeamBlackout(ctx echo.Context) error {
+	var req api.PostTeamBlackoutJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	var recurrence string
+	if req.Recurrence != nil {
+		recurrence = *req.Recurrence
+	}
+
+	window, err := h.service.CreateBlackoutWindow(ctx.Request().Context(), req.TeamName, req.StartsAt, req.EndsAt, recurrence)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(201, map[string]interface{}{
+		"window": api.BlackoutWindow{
+			Id:         window.ID,
+			TeamName:   window.TeamName,
+			StartsAt:   window.StartsAt,
+			EndsAt:     window.EndsAt,
+			Recurrence: window.Recurrence,
+		},
+	})
+}
+
+func (h *Handler) PostTeamOwnership(ctx echo.Context) error {
+	var req api.PostTeamOwnershipJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	rules := make([]service.OwnershipRule, len(req.Rules))
+	for i, rule := range req.Rules {
+		rules[i] = service.OwnershipRule{
+			Pattern: rule.Pattern,
+			Owners:  rule.Owners,
+		}
+	}
+
+	stored, err := h.service.SetPathOwnership(ctx.Request().Context(), req.TeamName, rules)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	apiRules := make([]api.OwnershipRule, len(stored))
+	for i, rule := range stored {
+		apiRules[i] = api.OwnershipRule{
+			Pattern: rule.Pattern,
+			Owners:  rule.Owners,
+		}
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"team_name": req.TeamName,
+		"rules":     apiRules,
+	})
+}
+
+func (h *Handler) PostTeamQuota(ctx echo.Context) error {
+	var req api.PostTeamQuotaJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	if err := h.service.SetTeamQuota(ctx.Request().Context(), req.TeamName, req.DefaultWeeklyQuota); err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"team_name":            req.TeamName,
+		"default_weekly_quota": req.DefaultWeeklyQuota,
+	})
+}
+
+func (h *Handler) PostTeamRebalance(ctx echo.Context, params api.PostTeamRebalanceParams) error {
+	swaps, err := h.service.RebalanceTeam(ctx.Request().Context(), params.TeamName, params.MaxDeviation)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	apiSwaps := make([]api.RebalanceSwap, len(swaps))
+	for i, swap := range swaps {
+		apiSwaps[i] = api.RebalanceSwap{
+			PullRequestId: swap.PullRequestID,
+			FromUserId:    swap.FromUserID,
+			ToUserId:      swap.ToUserID,
+		}
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"team_name": params.TeamName,
+		"swaps":     apiSwaps,
+	})
+}
+
+func (h *Handler) PostTeamSettingsPreview(ctx echo.Context) error {
+	var req api.PostTeamSettingsPreviewJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	settings, previews, err := h.service.PreviewTeamSettings(ctx.Request().Context(), req.TeamName, req.Strategy, req.RequiredReviewers)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	apiPreviews := make([]api.AssignmentPreview, len(previews))
+	for i, preview := range previews {
+		apiPreviews[i] = api.AssignmentPreview{
+			PullRequest: api.PullRequestShort{
+				PullRequestId:   preview.PullRequest.PullRequestID,
+				PullRequestName: preview.PullRequest.PullRequestName,
+				AuthorId:        preview.PullRequest.AuthorID,
+				Status:          api.PullRequestShortStatus(preview.PullRequest.Status),
+			},
+			CurrentReviewers:  getUserIDs(preview.CurrentReviewers),
+			ProposedReviewers: getUserIDs(preview.ProposedReviewers),
+			Unfilled:          preview.Unfilled,
+		}
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"team_name":          req.TeamName,
+		"strategy":           settings.Strategy,
+		"required_reviewers": settings.RequiredReviewers,
+		"pull_requests":      apiPreviews,
+	})
+}
+
+func (h *Handler) PostTeamSnapshotDiff(ctx echo.Context) error {
+	var req api.PostTeamSnapshotDiffJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	diff, err := service.DiffTeamSnapshots(convertSnapshotFromAPI(req.Before), convertSnapshotFromAPI(req.After))
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	modified := make([]api.MemberChange, len(diff.ModifiedMembers))
+	for i, change := range diff.ModifiedMembers {
+		modified[i] = api.MemberChange{
+			UserId: change.After.UserID,
+			Before: convertMemberToAPI(change.Before),
+			After:  convertMemberToAPI(change.After),
+		}
+	}
+
+	return ctx.JSON(200, api.TeamSnapshotDiff{
+		AddedMembers:       convertMembersToAPI(diff.AddedMembers),
+		RemovedMembers:     convertMembersToAPI(diff.RemovedMembers),
+		ModifiedMembers:    modified,
+		AddedAssignments:   convertSnapshotAssignmentsToAPI(diff.AddedAssignments),
+		RemovedAssignments: convertSnapshotAssignmentsToAPI(diff.RemovedAssignments),
+	})
+}
+
+func (h *Handler) GetPullRequestGet(ctx echo.Context, params api.GetPullRequestGetParams) error {
+	pr, err := h.service.GetPR(ctx.Request().Context(), prKey(params.TeamName, params.PullRequestId))
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	filtered, err := filterFields(convertPullRequestToAPI(pr), params.Fields, params.Strict)
+	if err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", err.Error()))
+	}
+
+	return ctx.JSON(200, filtered)
+}
+
+func (h *Handler) GetPullRequestHistory(ctx echo.Context, params api.GetPullRequestHistoryParams) error {
+	events, err := h.service.GetPRHistory(ctx.Request().Context(), prKey(params.TeamName, params.PullRequestId))
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	apiEvents := make([]api.ReassignmentEvent, len(events))
+	for i, e := range events {
+		apiEvents[i] = api.ReassignmentEvent{
+			OldUserId:  e.OldUserID,
+			NewUserId:  e.NewUserID,
+			OccurredAt: e.OccurredAt,
+		}
+		if e.Reason != "" {
+			apiEvents[i].Reason = &events[i].Reason
+		}
+	}
+
+	filtered, err := filterFields(apiEvents, params.Fields, params.Strict)
+	if err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", err.Error()))
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"pull_request_id": params.PullRequestId,
+		"events":          filtered,
+	})
+}
+
+func (h *Handler) GetPullRequestWhyAssigned(ctx echo.Context, params api.GetPullRequestWhyAssignedParams) error {
+	explanations, err := h.service.ExplainAssignment(ctx.Request().Context(), prKey(params.TeamName, params.PullRequestId))
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	reviewers := make([]api.AssignmentExplanation, len(explanations))
+	for i, e := range explanations {
+		reviewers[i] = api.AssignmentExplanation{
+			UserId:           e.Assignment.UserID,
+			AssignedAt:       e.Assignment.AssignedAt,
+			Reason:           e.Assignment.Reason.Reason,
+			Strategy:         e.Assignment.Reason.Strategy,
+			Detail:           e.Assignment.Reason.Detail,
+			LoadAtAssignment: e.Assignment.LoadAtAssignment,
+			Explanation:      e.Explanation,
+		}
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"pull_request_id": params.PullRequestId,
+		"reviewers":       reviewers,
+	})
+}
+
+func (h *Handler) GetTeamGet(ctx echo.Context, params api.GetTeamGetParams) error {
+	if params.Expand != nil && *params.Expand != service.ExpandLoad {
+		return handleServiceError(ctx, service.ErrInvalidExpand)
+	}
+
+	team, members, err := h.service.GetTeam(ctx.Request().Context(), params.TeamName)
+	if err != nil {
+		return ctx.JSON(404, createError("NOT_FOUND", err.Error()))
+	}
+
+	apiMembers := make([]api.TeamMember, len(members))
+	for i, m := range members {
+		apiMembers[i] = api.TeamMember{
+			UserId:   m.UserID,
+			Username: m.Username,
+			IsActive: m.IsActive,
+		}
+	}
+
+	if params.Expand != nil {
+		load, err := h.service.GetTeamMemberLoad(ctx.Request().Context(), team.Name, members)
+		if err != nil {
+			return handleServiceError(ctx, err)
+		}
+		for i, m := range members {
+			memberLoad := load[m.UserID]
+			apiMembers[i].OpenReviews = &memberLoad.OpenReviews
+			apiMembers[i].IsAvailable = &memberLoad.Available
+		}
+	}
+
+	filtered, err := filterFields(apiMembers, params.Fields, params.Strict)
+	if err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", err.Error()))
+	}
+
+	response := api.Team{
+		TeamName:          team.Name,
+		Members:           apiMembers,
+		RequiredReviewers: &team.RequiredReviewers,
+	}
+	if err := h.setFallbackTeams(ctx, &response); err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, struct {
+		api.Team
+		Members interface{} `json:"members"`
+	}{Team: response, Members: filtered})
+}
+
+func (h *Handler) setFallbackTeams(ctx echo.Context, team *api.Team) error {
+	fallbackTeams, err := h.service.GetFallbackTeams(ctx.Request().Context(), team.TeamName)
+	if err != nil {
+		return err
+	}
+	if len(fallbackTeams) > 0 {
+		team.FallbackTeams = &fallbackTeams
+	}
+	return nil
+}
+
+func (h *Handler) PatchTeamMember(ctx echo.Context) error {
+	var req api.PatchTeamMemberJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	user, err := h.service.UpdateTeamMember(ctx.Request().Context(), req.TeamName, service.MemberUpdate{
+		UserID:   req.UserId,
+		Username: req.Username,
+		IsActive: req.IsActive,
+	})
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"team_name": user.TeamName,
+		"member": api.TeamMember{
+			UserId:   user.UserID,
+			Username: user.Username,
+			IsActive: user.IsActive,
+		},
+	})
+}
+
+func (h *Handler) GetUsersGetReview(ctx echo.Context, params api.GetUsersGetReviewParams) error {
+	var status *string
+	if params.Status != nil {
+		value := string(*params.Status)
+		status = &value
+	}
+
+	prs, total, err := h.service.GetUserAssignedPRs(ctx.Request().Context(), params.UserId, status, params.Limit, params.Offset)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	shortPRs := make([]api.PullRequestShort, len(prs))
+	for i, pr := range prs {
+		shortPRs[i] = api.PullRequestShort{
+			PullRequestId:   pr.PullRequest.PullRequestID,
+			PullRequestName: pr.PullRequest.PullRequestName,
+			AuthorId:        pr.PullRequest.AuthorID,
+			Status:          api.PullRequestShortStatus(pr.PullRequest.Status),
+		}
+	}
+
+	filtered, err := filterFields(shortPRs, params.Fields, params.Strict)
+	if err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", err.Error()))
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"user_id":       params.UserId,
+		"total":         total,
+		"pull_requests": filtered,
+	})
+}
+
+func (h *Handler) PostUsersApiKey(ctx echo.Context) error {
+	var req api.PostUsersApiKeyJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	if err := h.authorizeKeyOwner(ctx, req.UserId); err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	key, err := h.service.IssueAPIKey(ctx.Request().Context(), req.UserId)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(201, map[string]interface{}{
+		"user_id":    key.UserID,
+		"api_key":    key.Key,
+		"created_at": key.CreatedAt,
+	})
+}
+
+func (h *Handler) PostUsersApiKeyRevoke(ctx echo.Context) error {
+	var req api.PostUsersApiKeyRevokeJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	if err := h.authorizeKeyOwner(ctx, req.UserId); err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	revocation, err := h.service.RevokeAPIKeys(ctx.Request().Context(), req.UserId)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"user_id":    revocation.UserID,
+		"revoked":    revocation.Revoked,
+		"revoked_at": revocation.RevokedAt,
+	})
+}
+
+func (h *Handler) GetUsersAssignments(ctx echo.Context, params api.GetUsersAssignmentsParams) error {
+	history, err := h.service.GetUserAssignmentHistory(ctx.Request().Context(), params.UserId, params.Since, params.Until, params.Limit, params.Offset)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	assignments := make([]api.ReviewAssignment, len(history.Assignments))
+	for i, a := range history.Assignments {
+		assignments[i] = api.ReviewAssignment{
+			AssignedAt: a.AssignedAt,
+			PullRequest: api.PullRequestShort{
+				PullRequestId:   a.PullRequest.PullRequestID,
+				PullRequestName: a.PullRequest.PullRequestName,
+				AuthorId:        a.PullRequest.AuthorID,
+				Status:          api.PullRequestShortStatus(a.PullRequest.Status),
+			},
+		}
+	}
+
+	filtered, err := filterFields(assignments, params.Fields, params.Strict)
+	if err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", err.Error()))
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"user_id":     history.UserID,
+		"since":       history.Since,
+		"until":       history.Until,
+		"total":       history.Total,
+		"assignments": filtered,
+	})
+}
+
+func (h *Handler) GetUsersPeakLoad(ctx echo.Context, params api.GetUsersPeakLoadParams) error {
+	peak, err := h.service.GetPeakLoad(ctx.Request().Context(), params.UserId, params.Since)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"user_id": peak.UserID,
+		"since":   peak.Since,
+		"peak":    peak.Peak,
+		"peak_at": peak.PeakAt,
+	})
+}
+
+func (h *Handler) PostUsersBoost(ctx echo.Context) error {
+	var req api.PostUsersBoostJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	user, err := h.service.BoostUser(ctx.Request().Context(), req.UserId, req.BoostFactor, req.ExpiresAt)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"user": api.User{
+			UserId:   user.UserID,
+			Username: user.Username,
+			TeamName: user.TeamName,
+			IsActive: user.IsActive,
+		},
+		"boost_factor": req.BoostFactor,
+		"expires_at":   req.ExpiresAt,
+	})
+}
+
+func (h *Handler) PostUsersQuota(ctx echo.Context) error {
+	var req api.PostUsersQuotaJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	user, err := h.service.SetUserQuota(ctx.Request().Context(), req.UserId, req.WeeklyQuota)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"user": api.User{
+			UserId:   user.UserID,
+			Username: user.Username,
+			TeamName: user.TeamName,
+			IsActive: user.IsActive,
+		},
+		"weekly_quota": req.WeeklyQuota,
+	})
+}
+
+func (h *Handler) GetUsersReassignImpact(ctx echo.Context, params api.GetUsersReassignImpactParams) error {
+	impact, err := h.service.GetReassignImpact(ctx.Request().Context(), params.UserId)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	withoutReplacement := 0
+	apiImpact := make([]api.ReassignImpact, len(impact))
+	for i, item := range impact {
+		apiImpact[i] = api.ReassignImpact{
+			PullRequestId:   item.PullRequest.PullRequestID,
+			PullRequestName: item.PullRequest.PullRequestName,
+			AuthorId:        item.PullRequest.AuthorID,
+			Candidates:      item.Candidates,
+		}
+		if item.Candidates == 0 {
+			withoutReplacement++
+		}
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"user_id":             params.UserId,
+		"pull_requests":       apiImpact,
+		"without_replacement": withoutReplacement,
+	})
+}
+
+func (h *Handler) PostUsersSetIsActive(ctx echo.Context) error {
+	var req api.PostUsersSetIsActiveJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	if err := h.authorizeUser(ctx, req.UserId); err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	reassign := req.ReassignOpenReviews != nil && *req.ReassignOpenReviews
+	user, handoff, err := h.service.SetUserActive(ctx.Request().Context(), req.UserId, req.IsActive, reassign)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	response := map[string]interface{}{
+		"user": api.User{
+			UserId:   user.UserID,
+			Username: user.Username,
+			TeamName: user.TeamName,
+			IsActive: user.IsActive,
+		},
+	}
+	if handoff != nil {
+		reassigned := make([]api.ReviewReassignment, len(handoff.Reassigned))
+		for i, r := range handoff.Reassigned {
+			reassigned[i] = api.ReviewReassignment{
+				PullRequestId: r.PullRequestID,
+				ReplacedBy:    r.ReplacedBy,
+			}
+		}
+		response["reassigned"] = reassigned
+		response["no_candidate"] = handoff.NoCandidate
+	}
+
+	return ctx.JSON(200, response)
+}
+
+func (h *Handler) PostUsersSkills(ctx echo.Context) error {
+	var req api.PostUsersSkillsJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(400, createError("INVALID_REQUEST", "Invalid request body"))
+	}
+
+	user, skills, err := h.service.SetUserSkills(ctx.Request().Context(), req.UserId, req.Skills)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"user": api.User{
+			UserId:   user.UserID,
+			Username: user.Username,
+			TeamName: user.TeamName,
+			IsActive: user.IsActive,
+		},
+		"skills": skills,
+	})
+}
+
+func createError(code, message string) api.ErrorResponse {
+	return api.ErrorResponse{
+		Error: struct {
+			Code    api.ErrorResponseErrorCode `json:"code"`
+			Message string                     `json:"message"`
+		}{
+			Code:    api.ErrorResponseErrorCode(code),
+			Message: message,
+		},
+	}
+}
+
+// prKey builds the key of the PR a request targets; without a team the id
+// is looked up across all teams.
+func prKey(teamName *string, prID string) store.PRKey {
+	key := store.PRKey{PullRequestID: prID}
+	if teamName != nil {
+		key.TeamName = *teamName
+	}
+	return key
+}
+
+func setLocation(ctx echo.Context, path, param, value string) {
+	ctx.Response().Header().Set(echo.HeaderLocation, path+"?"+url.Values{param: {value}}.Encode())
+}
+
+func handleServiceError(ctx echo.Context, err error) error {
+	var limitErr *service.RateLimitError
+	if errors.As(err, &limitErr) {
+		seconds := int(math.Ceil(limitErr.RetryAfter.Seconds()))
+		if seconds < 1 {
+			seconds = 1
+		}
+		ctx.Response().Header().Set("Retry-After", strconv.Itoa(seconds))
+		return ctx.JSON(429, createError("RATE_LIMITED", err.Error()))
+	}
+
+	switch {
+	case errors.Is(err, service.ErrPRExists):
+		return ctx.JSON(409, createError("PR_EXISTS", err.Error()))
+	case errors.Is(err, service.ErrTeamExists):
+		return ctx.JSON(409, createError("TEAM_EXISTS", err.Error()))
+	case isAny(err, service.ErrPRMerged, service.ErrPRMergedEdit, service.ErrPRMergedClose, service.ErrPRMergedApprove):
+		return ctx.JSON(409, createError("PR_MERGED", err.Error()))
+	case isAny(err, service.ErrPRClosed, service.ErrPRClosedMerge, service.ErrPRClosedApprove):
+		return ctx.JSON(409, createError("PR_CLOSED", err.Error()))
+	case errors.Is(err, service.ErrInsufficientApprovals):
+		return ctx.JSON(409, createError("INSUFFICIENT_APPROVALS", err.Error()))
+	case errors.Is(err, service.ErrNotAssigned):
+		return ctx.JSON(409, createError("NOT_ASSIGNED", err.Error()))
+	case errors.Is(err, service.ErrNoCandidate):
+		return ctx.JSON(409, createError("NO_CANDIDATE", err.Error()))
+	case errors.Is(err, service.ErrInvalidCandidate):
+		return ctx.JSON(409, createError("INVALID_CANDIDATE", err.Error()))
+	case errors.Is(err, service.ErrReassignConflict):
+		return ctx.JSON(409, createError("REASSIGN_CONFLICT", err.Error()))
+	case errors.Is(err, service.ErrNotFound):
+		return ctx.JSON(404, createError("NOT_FOUND", err.Error()))
+	case isAny(err, service.ErrInvalidBucket, service.ErrInvalidRange, service.ErrInvalidExpand, service.ErrInvalidFactor,
+		service.ErrInvalidLimit, service.ErrInvalidOffset, service.ErrInvalidWindow, service.ErrInvalidExpiry,
+		service.ErrInvalidMemberRange, service.ErrInvalidRecurrence, service.ErrInvalidBlackout,
+		service.ErrInvalidPattern, service.ErrOwnerNotInTeam, service.ErrInvalidFormat, service.ErrInvalidQuota,
+		service.ErrInvalidDeviation, service.ErrInvalidPRExpand, service.ErrSnapshotVersion,
+		service.ErrInvalidThreshold, service.ErrInvalidStrategy, service.ErrInvalidRequired,
+		service.ErrInvalidSkill, service.ErrInvalidWebhook, service.ErrInvalidPriority, service.ErrInvalidStatus,
+		service.ErrInvalidFallback):
+		return ctx.JSON(400, createError("INVALID_REQUEST", err.Error()))
+	case isAny(err, service.ErrInvalidAPIKey, service.ErrUnauthenticated):
+		return ctx.JSON(401, createError("UNAUTHORIZED", err.Error()))
+	case errors.Is(err, service.ErrForbidden):
+		return ctx.JSON(403, createError("FORBIDDEN", err.Error()))
+	case errors.Is(err, service.ErrBlackoutOverlap):
+		return ctx.JSON(409, createError("BLACKOUT_OVERLAP", err.Error()))
+	case errors.Is(err, service.ErrMemberOtherTeam):
+		return ctx.JSON(409, createError("MEMBER_IN_OTHER_TEAM", err.Error()))
+	case errors.Is(err, store.ErrAmbiguousPR):
+		return ctx.JSON(400, createError("INVALID_REQUEST", err.Error()))
+	case errors.Is(err, store.ErrDuplicateKey):
+		return ctx.JSON(409, createError("CONFLICT", err.Error()))
+	case isAny(err, service.ErrEmptyPRName, service.ErrTeamRequired, service.ErrInvalidMember):
+		return ctx.JSON(422, createError("VALIDATION_ERROR", err.Error()))
+	default:
+		return ctx.JSON(500, createError("INTERNAL_ERROR", err.Error()))
+	}
+}
+
+func isAny(err error, targets ...error) bool {
+	for _, target := range targets {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+var httpErrorCodes = map[int]string{
+	http.StatusBadRequest:            "INVALID_REQUEST",
+	http.StatusUnauthorized:          "UNAUTHORIZED",
+	http.StatusForbidden:             "FORBIDDEN",
+	http.StatusNotFound:              "ROUTE_NOT_FOUND",
+	http.StatusMethodNotAllowed:      "METHOD_NOT_ALLOWED",
+	http.StatusRequestEntityTooLarge: "PAYLOAD_TOO_LARGE",
+	http.StatusUnsupportedMediaType:  "UNSUPPORTED_MEDIA_TYPE",
+	http.StatusTooManyRequests:       "RATE_LIMITED",
+	http.StatusServiceUnavailable:    "OVERLOADED",
+}
+
+func HTTPErrorHandler(err error, ctx echo.Context) {
+	if ctx.Response().Committed {
+		return
+	}
+
+	status := http.StatusInternalServerError
+	code := "INTERNAL_ERROR"
+	message := err.Error()
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		status = httpErr.Code
+		message = fmt.Sprint(httpErr.Message)
+		if known, ok := httpErrorCodes[status]; ok {
+			code = known
+		} else if status != http.StatusInternalServerError {
+			code = strings.ToUpper(strings.ReplaceAll(http.StatusText(status), " ", "_"))
+		}
+	}
+
+	if ctx.Request().Method == http.MethodHead {
+		err = ctx.NoContent(status)
+	} else {
+		err = ctx.JSON(status, createError(code, message))
+	}
+	if err != nil {
+		log.Println("Failed to write error response:", err)
+	}
+}
+
+func convertPullRequestToAPI(pr *service.PullRequestWithReviewers) api.PullRequest {
+	assignedReviewers := getUserIDs(pr.AssignedReviewers)
+
+	var teamName *string
+	if pr.PullRequest.TeamName != "" {
+		teamName = &pr.PullRequest.TeamName
+	}
+
+	var approvals *[]api.Approval
+	if pr.Approvals != nil {
+		converted := convertApprovalsToAPI(pr.Approvals)
+		approvals = &converted
+	}
+
+	return api.PullRequest{
+		PullRequestId:     pr.PullRequest.PullRequestID,
+		PullRequestName:   pr.PullRequest.PullRequestName,
+		AuthorId:          pr.PullRequest.AuthorID,
+		Status:            api.PullRequestStatus(pr.PullRequest.Status),
+		AssignedReviewers: assignedReviewers,
+		CreatedAt:         &pr.PullRequest.CreatedAt,
+		MergedAt:          pr.PullRequest.MergedAt,
+		ReviewDeadline:    pr.PullRequest.ReviewDeadline,
+		TeamName:          teamName,
+		Approvals:         approvals,
+	}
+}
+
+func convertSnapshotFromAPI(snapshot api.TeamSnapshot) service.TeamSnapshot {
+	members := make([]service.TeamMember, len(snapshot.Members))
+	for i, m := range snapshot.Members {
+		members[i] = service.TeamMember{
+			UserID:   m.UserId,
+			Username: m.Username,
+			IsActive: m.IsActive,
+		}
+	}
+
+	assignments := make([]service.SnapshotAssignment, len(snapshot.Assignments))
+	for i, a := range snapshot.Assignments {
+		assignments[i] = service.SnapshotAssignment{
+			PullRequestID: a.PullRequestId,
+			UserID:        a.UserId,
+		}
+	}
+
+	return service.TeamSnapshot{
+		SchemaVersion: snapshot.SchemaVersion,
+		TeamName:      snapshot.TeamName,
+		Members:       members,
+		Assignments:   assignments,
+	}
+}
+
+func convertMemberToAPI(m service.TeamMember) api.TeamMember {
+	return api.TeamMember{
+		UserId:   m.UserID,
+		Username: m.Username,
+		IsActive: m.IsActive,
+	}
+}
+
+func convertMembersToAPI(members []service.TeamMember) []api.TeamMember {
+	result := make([]api.TeamMember, len(members))
+	for i, m := range members {
+		result[i] = convertMemberToAPI(m)
+	}
+	return result
+}
+
+func convertSnapshotAssignmentsToAPI(assignments []service.SnapshotAssignment) []api.SnapshotAssignment {
+	result := make([]api.SnapshotAssignment, len(assignments))
+	for i, a := range assignments {
+		result[i] = api.SnapshotAssignment{
+			PullRequestId: a.PullRequestID,
+			UserId:        a.UserID,
+		}
+	}
+	return result
+}
+
+func getUserIDs(users []store.User) []string {
+	ids := make([]string, len(users))
+	for i, user := range users {
+		ids[i] = user.UserID
+	}
+	return ids
+}
@@ -0,0 +1,316 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"otbor_avito_november_2025/internal/api"
+	"otbor_avito_november_2025/internal/service"
+	"otbor_avito_november_2025/internal/store"
+
+	"github.com/labstack/echo/v4"
+)
+
+func newTestServer(t *testing.T, opts ...service.Option) *echo.Echo {
+	t.Helper()
+
+	e := echo.New()
+	e.HTTPErrorHandler = HTTPErrorHandler
+	api.RegisterHandlers(e, NewHandler(service.NewService(store.NewMemoryStore(), opts...)))
+	return e
+}
+
+func doRequest(e *echo.Echo, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestCreatePRRetryAfter(t *testing.T) {
+	tests := []struct {
+		perMinute int
+		burst     int
+	}{
+		{perMinute: 1, burst: 1},
+		{perMinute: 2, burst: 1},
+		{perMinute: 60, burst: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d per minute burst %d", tt.perMinute, tt.burst), func(t *testing.T) {
+			e := newTestServer(t, service.WithCreateRateLimit(tt.perMinute, tt.burst))
+			rec := doRequest(e, http.MethodPost, "/team/add",
+				`{"team_name":"backend","members":[{"user_id":"u1","username":"Alice","is_active":true}]}`)
+			if rec.Code != http.StatusCreated {
+				t.Fatalf("create team: status %d: %s", rec.Code, rec.Body)
+			}
+
+			for i := 0; ; i++ {
+				body := fmt.Sprintf(`{"pull_request_id":"pr-%d","pull_request_name":"Add search","author_id":"u1"}`, i)
+				rec = doRequest(e, http.MethodPost, "/pullRequest/create", body)
+				if rec.Code != http.StatusCreated {
+					break
+				}
+				if i >= tt.burst {
+					t.Fatalf("request %d was not rate limited with burst %d", i+1, tt.burst)
+				}
+			}
+			if rec.Code != http.StatusTooManyRequests {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusTooManyRequests, rec.Body)
+			}
+
+			retryAfter, err := strconv.Atoi(rec.Header().Get("Retry-After"))
+			if err != nil {
+				t.Fatalf("Retry-After %q is not a number of seconds", rec.Header().Get("Retry-After"))
+			}
+			window := 60 / tt.perMinute
+			if retryAfter < 1 || retryAfter > window {
+				t.Fatalf("Retry-After = %d, want between 1 and %d", retryAfter, window)
+			}
+		})
+	}
+}
+
+func TestAdminRoutes(t *testing.T) {
+	e := echo.New()
+	e.HTTPErrorHandler = HTTPErrorHandler
+	handler := NewHandler(service.NewService(store.NewMemoryStore()))
+	api.RegisterHandlers(NewAdminRouter(e, AdminAuth(NewAdminTokens("secret"))), handler)
+
+	if rec := doRequest(e, http.MethodGet, "/admin/unknown", ""); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("unknown admin route: status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+
+	for _, route := range e.Routes() {
+		if route.Method == echo.RouteNotFound || !RequiresAdmin(route.Method, route.Path) {
+			continue
+		}
+		t.Run(route.Method+" "+route.Path, func(t *testing.T) {
+			path := strings.ReplaceAll(route.Path, ":id", "1")
+			if rec := doRequest(e, route.Method, path, `{}`); rec.Code != http.StatusUnauthorized {
+				t.Fatalf("without token: status = %d, want %d", rec.Code, http.StatusUnauthorized)
+			}
+
+			req := httptest.NewRequest(route.Method, path, strings.NewReader(`{}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer secret")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			if rec.Code == http.StatusUnauthorized {
+				t.Fatalf("with token: status = %d: %s", rec.Code, rec.Body)
+			}
+		})
+	}
+}
+
+func TestAdminRoutesMatchSpec(t *testing.T) {
+	swagger, err := api.GetSwagger()
+	if err != nil {
+		t.Fatalf("load spec: %v", err)
+	}
+
+	for path, item := range swagger.Paths.Map() {
+		for method, op := range item.Operations() {
+			adminOnly := op.Security != nil && len(*op.Security) == 1 && (*op.Security)[0]["bearerAuth"] != nil
+			route := strings.NewReplacer("{", ":", "}", "").Replace(path)
+			if got := RequiresAdmin(method, route); got != adminOnly {
+				t.Errorf("RequiresAdmin(%s %s) = %v, spec requires admin token: %v", method, route, got, adminOnly)
+			}
+		}
+	}
+}
+
+func TestPRTeamScope(t *testing.T) {
+	e := newTestServer(t)
+	for _, team := range []string{"backend", "frontend"} {
+		body := fmt.Sprintf(`{"team_name":%q,"members":[{"user_id":"%s-1","username":"Alice","is_active":true},{"user_id":"%s-2","username":"Bob","is_active":true}]}`, team, team, team)
+		if rec := doRequest(e, http.MethodPost, "/team/add", body); rec.Code != http.StatusCreated {
+			t.Fatalf("create team %s: status %d: %s", team, rec.Code, rec.Body)
+		}
+	}
+	rec := doRequest(e, http.MethodPost, "/pullRequest/create", `{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"backend-1"}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create PR: status %d: %s", rec.Code, rec.Body)
+	}
+	rec = doRequest(e, http.MethodPost, "/pullRequest/create", `{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"frontend-1"}`)
+	if rec.Code != http.StatusConflict {
+		t.Fatalf("create PR with the same id in another team: status = %d, want %d: %s", rec.Code, http.StatusConflict, rec.Body)
+	}
+
+	tests := []struct {
+		name string
+		body string
+		want int
+	}{
+		{name: "other team", body: `{"pull_request_id":"pr-1","team_name":"frontend"}`, want: http.StatusNotFound},
+		{name: "owning team", body: `{"pull_request_id":"pr-1","team_name":"backend"}`, want: http.StatusOK},
+		{name: "no team", body: `{"pull_request_id":"pr-1"}`, want: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if rec := doRequest(e, http.MethodPost, "/pullRequest/close", tt.body); rec.Code != tt.want {
+				t.Fatalf("close: status = %d, want %d: %s", rec.Code, tt.want, rec.Body)
+			}
+		})
+	}
+}
+
+func TestTeamScopedPRIDs(t *testing.T) {
+	st := store.NewMemoryStore()
+	if err := st.SetTeamScopedPRIDs(context.Background(), true); err != nil {
+		t.Fatalf("set team scoped PR ids: %v", err)
+	}
+	e := echo.New()
+	e.HTTPErrorHandler = HTTPErrorHandler
+	api.RegisterHandlers(e, NewHandler(service.NewService(st)))
+
+	for _, team := range []string{"backend", "frontend"} {
+		body := fmt.Sprintf(`{"team_name":%q,"members":[{"user_id":"%s-1","username":"Alice","is_active":true},{"user_id":"%s-2","username":"Bob","is_active":true}]}`, team, team, team)
+		if rec := doRequest(e, http.MethodPost, "/team/add", body); rec.Code != http.StatusCreated {
+			t.Fatalf("create team %s: status %d: %s", team, rec.Code, rec.Body)
+		}
+		body = fmt.Sprintf(`{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"%s-1"}`, team)
+		if rec := doRequest(e, http.MethodPost, "/pullRequest/create", body); rec.Code != http.StatusCreated {
+			t.Fatalf("create PR in %s: status %d: %s", team, rec.Code, rec.Body)
+		}
+	}
+	if rec := doRequest(e, http.MethodPost, "/pull-request/approve", `{"pull_request_id":"pr-1","user_id":"backend-2","team_name":"backend"}`); rec.Code != http.StatusOK {
+		t.Fatalf("approve: status %d: %s", rec.Code, rec.Body)
+	}
+	if rec := doRequest(e, http.MethodPost, "/pullRequest/merge", `{"pull_request_id":"pr-1","team_name":"backend"}`); rec.Code != http.StatusOK {
+		t.Fatalf("merge: status %d: %s", rec.Code, rec.Body)
+	}
+
+	tests := []struct {
+		name       string
+		path       string
+		want       int
+		wantAuthor string
+		wantStatus string
+	}{
+		{name: "no team", path: "/pull-request/get?pull_request_id=pr-1", want: http.StatusBadRequest},
+		{name: "backend", path: "/pull-request/get?pull_request_id=pr-1&team_name=backend", want: http.StatusOK, wantAuthor: "backend-1", wantStatus: "MERGED"},
+		{name: "frontend", path: "/pull-request/get?pull_request_id=pr-1&team_name=frontend", want: http.StatusOK, wantAuthor: "frontend-1", wantStatus: "OPEN"},
+		{name: "unknown team", path: "/pull-request/get?pull_request_id=pr-1&team_name=mobile", want: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := doRequest(e, http.MethodGet, tt.path, "")
+			if rec.Code != tt.want {
+				t.Fatalf("get: status = %d, want %d: %s", rec.Code, tt.want, rec.Body)
+			}
+			if tt.want != http.StatusOK {
+				return
+			}
+			var pr struct {
+				AuthorID string `json:"author_id"`
+				Status   string `json:"status"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &pr); err != nil {
+				t.Fatalf("decode PR: %v: %s", err, rec.Body)
+			}
+			if pr.AuthorID != tt.wantAuthor || pr.Status != tt.wantStatus {
+				t.Fatalf("got author %q status %q, want %q %q", pr.AuthorID, pr.Status, tt.wantAuthor, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestListFields(t *testing.T) {
+	e := newTestServer(t)
+	body := `{"team_name":"backend","members":[{"user_id":"u1","username":"Alice","is_active":true},{"user_id":"u2","username":"Bob","is_active":true},{"user_id":"u3","username":"Carol","is_active":true},{"user_id":"u4","username":"Dave","is_active":true}]}`
+	if rec := doRequest(e, http.MethodPost, "/team/add", body); rec.Code != http.StatusCreated {
+		t.Fatalf("create team: status %d: %s", rec.Code, rec.Body)
+	}
+	rec := doRequest(e, http.MethodPost, "/pullRequest/create", `{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1"}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create PR: status %d: %s", rec.Code, rec.Body)
+	}
+	var created struct {
+		PR struct {
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		} `json:"pr"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil || len(created.PR.AssignedReviewers) == 0 {
+		t.Fatalf("decode created PR: %v: %s", err, rec.Body)
+	}
+	reassign := fmt.Sprintf(`{"pull_request_id":"pr-1","old_user_id":%q}`, created.PR.AssignedReviewers[0])
+	if rec := doRequest(e, http.MethodPost, "/pullRequest/reassign", reassign); rec.Code != http.StatusOK {
+		t.Fatalf("reassign: status %d: %s", rec.Code, rec.Body)
+	}
+
+	tests := []struct {
+		path  string
+		list  string
+		field string
+	}{
+		{path: "/team/get?team_name=backend", list: "members", field: "user_id"},
+		{path: "/users/assignments?user_id=" + created.PR.AssignedReviewers[1], list: "assignments", field: "assigned_at"},
+		{path: "/admin/teams?min_members=1", list: "teams", field: "team_name"},
+		{path: "/admin/high-churn-prs?min_reassigns=1", list: "pull_requests", field: "reassignments"},
+		{path: "/pull-request/history?pull_request_id=pr-1", list: "events", field: "new_user_id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rec := doRequest(e, http.MethodGet, tt.path+"&fields="+tt.field, "")
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d: %s", rec.Code, rec.Body)
+			}
+			var resp map[string][]map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				if _, ok := err.(*json.UnmarshalTypeError); !ok {
+					t.Fatalf("decode response: %v", err)
+				}
+			}
+			if len(resp[tt.list]) == 0 {
+				t.Fatalf("%s is empty: %s", tt.list, rec.Body)
+			}
+			for _, item := range resp[tt.list] {
+				if _, ok := item[tt.field]; !ok || len(item) != 1 {
+					t.Fatalf("%s item = %v, want only %s", tt.list, item, tt.field)
+				}
+			}
+
+			rec = doRequest(e, http.MethodGet, tt.path+"&fields=unknown&strict=true", "")
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("strict unknown field: status = %d, want %d", rec.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestGetPullRequestFields(t *testing.T) {
+	e := newTestServer(t)
+	body := `{"team_name":"backend","members":[{"user_id":"u1","username":"Alice","is_active":true},{"user_id":"u2","username":"Bob","is_active":true}]}`
+	if rec := doRequest(e, http.MethodPost, "/team/add", body); rec.Code != http.StatusCreated {
+		t.Fatalf("create team: status %d: %s", rec.Code, rec.Body)
+	}
+	if rec := doRequest(e, http.MethodPost, "/pullRequest/create", `{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1"}`); rec.Code != http.StatusCreated {
+		t.Fatalf("create PR: status %d: %s", rec.Code, rec.Body)
+	}
+
+	rec := doRequest(e, http.MethodGet, "/pull-request/get?pull_request_id=pr-1&fields=status,author_id", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", rec.Code, rec.Body)
+	}
+	var pr map[string]interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &pr); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if len(pr) != 2 || pr["status"] != "OPEN" || pr["author_id"] != "u1" {
+		t.Fatalf("PR = %v, want only status and author_id", pr)
+	}
+
+	rec = doRequest(e, http.MethodGet, "/pull-request/get?pull_request_id=pr-1&fields=unknown&strict=true", "")
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("strict unknown field: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
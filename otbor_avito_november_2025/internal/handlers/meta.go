@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"otbor_avito_november_2025/internal/api"
+
+	"github.com/labstack/echo/v4"
+)
+
+const adminPathPrefix = "/admin"
+
+// adminOpsRoutes lists the ops routes outside adminPathPrefix that also
+// require an admin token. Everything under adminPathPrefix is gated as a group.
+var adminOpsRoutes = map[string]bool{
+	http.MethodPost + " /team/blackout":  true,
+	http.MethodPatch + " /team/member":   true,
+	http.MethodPost + " /team/ownership": true,
+	http.MethodPost + " /team/quota":     true,
+	http.MethodPost + " /team/rebalance": true,
+	http.MethodPost + " /users/boost":    true,
+	http.MethodPost + " /users/quota":    true,
+	http.MethodPost + " /users/skills":   true,
+}
+
+func RequiresAdmin(method, path string) bool {
+	return strings.HasPrefix(path, adminPathPrefix+"/") || adminOpsRoutes[method+" "+path]
+}
+
+func (h *Handler) GetMetaEndpoints(ctx echo.Context) error {
+	var endpoints []api.EndpointInfo
+	for _, route := range ctx.Echo().Routes() {
+		if route.Method == echo.RouteNotFound {
+			continue
+		}
+		endpoints = append(endpoints, api.EndpointInfo{
+			Method:        route.Method,
+			Path:          route.Path,
+			RequiresAdmin: RequiresAdmin(route.Method, route.Path),
+		})
+	}
+
+	sort.Slice(endpoints, func(i, j int) bool {
+		if endpoints[i].Path == endpoints[j].Path {
+			return endpoints[i].Method < endpoints[j].Method
+		}
+		return endpoints[i].Path < endpoints[j].Path
+	})
+
+	return ctx.JSON(200, map[string]interface{}{
+		"endpoints": endpoints,
+	})
+}
@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"otbor_avito_november_2025/internal/api"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *Handler) GetTeamAssignmentTrend(ctx echo.Context, params api.GetTeamAssignmentTrendParams) error {
+	var bucket string
+	if params.Bucket != nil {
+		bucket = string(*params.Bucket)
+	}
+
+	trend, err := h.service.GetAssignmentTrend(ctx.Request().Context(), params.TeamName, params.Since, bucket)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	points := make([]api.AssignmentTrendPoint, len(trend.Points))
+	for i, p := range trend.Points {
+		points[i] = api.AssignmentTrendPoint{
+			BucketStart: p.BucketStart,
+			Assignments: p.Assignments,
+		}
+	}
+
+	return ctx.JSON(200, api.AssignmentTrend{
+		TeamName: trend.TeamName,
+		Bucket:   trend.Bucket,
+		Since:    trend.Since,
+		Points:   points,
+	})
+}
+
+func (h *Handler) GetTeamPoolTrend(ctx echo.Context, params api.GetTeamPoolTrendParams) error {
+	var bucket string
+	if params.Bucket != nil {
+		bucket = string(*params.Bucket)
+	}
+
+	trend, err := h.service.GetPoolTrend(ctx.Request().Context(), params.TeamName, params.Since, bucket)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	points := make([]api.PoolTrendPoint, len(trend.Points))
+	for i, p := range trend.Points {
+		points[i] = api.PoolTrendPoint{
+			BucketStart:      p.BucketStart,
+			ActiveMembers:    p.ActiveMembers,
+			AvailableMembers: p.AvailableMembers,
+		}
+	}
+
+	return ctx.JSON(200, api.PoolTrend{
+		TeamName: trend.TeamName,
+		Bucket:   trend.Bucket,
+		Since:    trend.Since,
+		Points:   points,
+	})
+}
+
+func (h *Handler) GetTeamLeaderboard(ctx echo.Context, params api.GetTeamLeaderboardParams) error {
+	page, err := h.service.GetLeaderboard(ctx.Request().Context(), params.TeamName, params.Since, params.Limit, params.Offset)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	entries := make([]api.LeaderboardEntry, len(page.Entries))
+	for i, e := range page.Entries {
+		entries[i] = api.LeaderboardEntry{
+			Rank:     e.Rank,
+			UserId:   e.UserID,
+			Username: e.Username,
+			Reviews:  e.Reviews,
+		}
+	}
+
+	return ctx.JSON(200, api.Leaderboard{
+		TeamName: page.TeamName,
+		Since:    page.Since,
+		Total:    page.Total,
+		Entries:  entries,
+	})
+}
+
+func (h *Handler) GetTeamCrossTeamReviews(ctx echo.Context, params api.GetTeamCrossTeamReviewsParams) error {
+	reviews, err := h.service.GetCrossTeamReviews(ctx.Request().Context(), params.TeamName, params.Since)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	members := make([]api.CrossTeamReviewer, len(reviews.Members))
+	for i, m := range reviews.Members {
+		members[i] = api.CrossTeamReviewer{
+			UserId:   m.UserID,
+			Username: m.Username,
+			Reviews:  m.Reviews,
+		}
+	}
+
+	return ctx.JSON(200, api.CrossTeamReviews{
+		TeamName: reviews.TeamName,
+		Since:    reviews.Since,
+		Total:    reviews.Total,
+		Members:  members,
+	})
+}
+
+func (h *Handler) GetTeamStats(ctx echo.Context, params api.GetTeamStatsParams) error {
+	stats, err := h.service.GetTeamStats(ctx.Request().Context(), params.TeamName)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	members := make([]api.MemberReviewStats, len(stats.Members))
+	for i, m := range stats.Members {
+		members[i] = api.MemberReviewStats{
+			UserId:        m.UserID,
+			Username:      m.Username,
+			OpenReviews:   m.OpenReviews,
+			MergedReviews: m.MergedReviews,
+		}
+	}
+
+	return ctx.JSON(200, api.TeamStats{
+		TeamName: stats.TeamName,
+		Members:  members,
+	})
+}
+
+func (h *Handler) GetTeamCoverageGaps(ctx echo.Context, params api.GetTeamCoverageGapsParams) error {
+	required, gaps, err := h.service.GetCoverageGaps(ctx.Request().Context(), params.TeamName)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	apiGaps := make([]api.CoverageGap, len(gaps))
+	for i, gap := range gaps {
+		apiGaps[i] = api.CoverageGap{
+			PullRequestId:      gap.PullRequest.PullRequestID,
+			PullRequestName:    gap.PullRequest.PullRequestName,
+			AuthorId:           gap.PullRequest.AuthorID,
+			AssignedReviewers:  gap.Reviewers,
+			MissingReviewers:   gap.Missing,
+			EligibleCandidates: gap.EligibleCandidates,
+			HasCandidates:      gap.EligibleCandidates > 0,
+		}
+	}
+
+	return ctx.JSON(200, map[string]interface{}{
+		"team_name":          params.TeamName,
+		"required_reviewers": required,
+		"gaps":               apiGaps,
+	})
+}
+
+func (h *Handler) GetTeamSla(ctx echo.Context, params api.GetTeamSlaParams) error {
+	sla, err := h.service.GetSLACompliance(ctx.Request().Context(), params.TeamName, params.Since)
+	if err != nil {
+		return handleServiceError(ctx, err)
+	}
+
+	return ctx.JSON(200, api.SLACompliance{
+		TeamName:  sla.TeamName,
+		Since:     sla.Since,
+		Compliant: sla.Compliant,
+		Total:     sla.Total,
+		Percent:   sla.Percent,
+	})
+}
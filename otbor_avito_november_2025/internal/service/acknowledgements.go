@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+func (s *Service) AcknowledgeReview(ctx context.Context, key store.PRKey, userID string) ([]store.ReviewerAcknowledgement, error) {
+	pr, err := s.store.GetPR(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if pr == nil {
+		return nil, ErrNotFound
+	}
+
+	ok, err := s.store.AcknowledgeReview(ctx, pr.Key(), userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNotAssigned
+	}
+
+	return s.store.GetPRAcknowledgements(ctx, pr.Key())
+}
+
+func (s *Service) GetPRAcknowledgements(ctx context.Context, key store.PRKey) ([]store.ReviewerAcknowledgement, error) {
+	pr, err := s.store.GetPR(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if pr == nil {
+		return nil, ErrNotFound
+	}
+
+	return s.store.GetPRAcknowledgements(ctx, pr.Key())
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+const (
+	defaultImbalanceFactor = 2.0
+
+	defaultOldestPendingLimit = 20
+	defaultAdminListLimit     = 20
+	maxListLimit              = 100
+
+	defaultMinReassigns = 3
+)
+
+type ImbalanceAlert struct {
+	TeamName    string
+	MeanLoad    float64
+	MaxLoad     int
+	Overloaded  []store.MemberOpenReviews
+	Underloaded []store.MemberOpenReviews
+}
+
+func (s *Service) GetImbalanceAlerts(ctx context.Context, factor *float64) (float64, []ImbalanceAlert, error) {
+	threshold := defaultImbalanceFactor
+	if factor != nil {
+		threshold = *factor
+	}
+	if threshold <= 1 {
+		return 0, nil, ErrInvalidFactor
+	}
+
+	loads, err := s.store.GetActiveMemberOpenReviews(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var teamOrder []string
+	byTeam := make(map[string][]store.MemberOpenReviews)
+	for _, load := range loads {
+		if _, ok := byTeam[load.TeamName]; !ok {
+			teamOrder = append(teamOrder, load.TeamName)
+		}
+		byTeam[load.TeamName] = append(byTeam[load.TeamName], load)
+	}
+
+	var alerts []ImbalanceAlert
+	for _, teamName := range teamOrder {
+		members := byTeam[teamName]
+
+		total, maxLoad := 0, 0
+		for _, member := range members {
+			total += member.OpenReviews
+			maxLoad = max(maxLoad, member.OpenReviews)
+		}
+		mean := float64(total) / float64(len(members))
+		if mean == 0 || float64(maxLoad) <= mean*threshold {
+			continue
+		}
+
+		alert := ImbalanceAlert{
+			TeamName: teamName,
+			MeanLoad: mean,
+			MaxLoad:  maxLoad,
+		}
+		for _, member := range members {
+			switch {
+			case float64(member.OpenReviews) > mean*threshold:
+				alert.Overloaded = append(alert.Overloaded, member)
+			case float64(member.OpenReviews) < mean:
+				alert.Underloaded = append(alert.Underloaded, member)
+			}
+		}
+		alerts = append(alerts, alert)
+	}
+
+	return threshold, alerts, nil
+}
+
+func (s *Service) GetOldestPendingPRs(ctx context.Context, limit *int) ([]*PullRequestWithReviewers, error) {
+	n, err := resolveLimit(limit, defaultOldestPendingLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	prs, err := s.store.GetOldestOpenPRs(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.withReviewers(ctx, prs)
+}
+
+func resolveOffset(offset *int) (int, error) {
+	if offset == nil {
+		return 0, nil
+	}
+	if *offset < 0 {
+		return 0, ErrInvalidOffset
+	}
+	return *offset, nil
+}
+
+func resolveLimit(limit *int, fallback int) (int, error) {
+	if limit == nil {
+		return fallback, nil
+	}
+	if *limit < 1 || *limit > maxListLimit {
+		return 0, ErrInvalidLimit
+	}
+	return *limit, nil
+}
+
+func (s *Service) FixPRStatusInconsistencies(ctx context.Context, dryRun bool) ([]store.PRStatusFix, error) {
+	return s.store.FixPRStatusInconsistencies(ctx, dryRun)
+}
+
+func (s *Service) GetSelfReviews(ctx context.Context) ([]store.SelfReview, error) {
+	return s.store.GetSelfReviews(ctx)
+}
+
+func (s *Service) RemoveSelfReviews(ctx context.Context) ([]store.SelfReview, error) {
+	return s.store.RemoveSelfReviews(ctx)
+}
+
+func (s *Service) GetInactiveReviewerAssignments(ctx context.Context) ([]store.InactiveAssignment, error) {
+	return s.store.GetInactiveReviewerAssignments(ctx)
+}
+
+func (s *Service) GetTeamsBySize(ctx context.Context, minMembers, maxMembers, limit, offset *int) ([]store.TeamSize, int, error) {
+	lower := 0
+	if minMembers != nil {
+		lower = *minMembers
+	}
+	if lower < 0 || (maxMembers != nil && *maxMembers < lower) {
+		return nil, 0, ErrInvalidMemberRange
+	}
+
+	n, err := resolveLimit(limit, defaultAdminListLimit)
+	if err != nil {
+		return nil, 0, err
+	}
+	skip, err := resolveOffset(offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return s.store.GetTeamsBySize(ctx, lower, maxMembers, n, skip)
+}
+
+func (s *Service) GetHighChurnPRs(ctx context.Context, minReassigns, limit, offset *int) ([]store.ChurnPR, int, error) {
+	threshold := defaultMinReassigns
+	if minReassigns != nil {
+		if *minReassigns < 1 {
+			return nil, 0, ErrInvalidThreshold
+		}
+		threshold = *minReassigns
+	}
+
+	n, err := resolveLimit(limit, defaultAdminListLimit)
+	if err != nil {
+		return nil, 0, err
+	}
+	skip, err := resolveOffset(offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return s.store.GetHighChurnPRs(ctx, threshold, n, skip)
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+func TestGetOldestPendingPRs(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t, []TeamMember{
+		{UserID: "u1", Username: "Alice", IsActive: true},
+		{UserID: "u2", Username: "Bob", IsActive: true},
+		{UserID: "u3", Username: "Carol", IsActive: true},
+	})
+
+	reviewers := make(map[string][]string)
+	for _, prID := range []string{"pr-1", "pr-2", "pr-3"} {
+		created, err := s.CreatePR(ctx, prID, "Add search", "u1", CreatePROptions{})
+		if err != nil {
+			t.Fatalf("create PR %s: %v", prID, err)
+		}
+		reviewers[prID] = reviewerIDs(created.AssignedReviewers)
+	}
+	if _, err := s.AcknowledgeReview(ctx, store.PRKey{PullRequestID: "pr-1"}, reviewers["pr-1"][0]); err != nil {
+		t.Fatalf("acknowledge PR: %v", err)
+	}
+	if _, err := s.ApprovePR(ctx, store.PRKey{PullRequestID: "pr-2"}, reviewers["pr-2"][0]); err != nil {
+		t.Fatalf("approve PR: %v", err)
+	}
+
+	prs, err := s.GetOldestPendingPRs(ctx, nil)
+	if err != nil {
+		t.Fatalf("GetOldestPendingPRs() error = %v", err)
+	}
+	if len(prs) != 1 || prs[0].PullRequest.PullRequestID != "pr-3" {
+		ids := make([]string, len(prs))
+		for i, pr := range prs {
+			ids[i] = pr.PullRequest.PullRequestID
+		}
+		t.Fatalf("GetOldestPendingPRs() = %v, want [pr-3]", ids)
+	}
+}
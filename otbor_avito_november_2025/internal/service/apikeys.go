@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+const apiKeyPrefix = "prk_"
+
+type APIKey struct {
+	UserID    string
+	Key       string
+	CreatedAt time.Time
+}
+
+type APIKeyRevocation struct {
+	UserID    string
+	Revoked   int
+	RevokedAt time.Time
+}
+
+func (s *Service) IssueAPIKey(ctx context.Context, userID string) (*APIKey, error) {
+	user, err := s.store.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrNotFound
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	key := &APIKey{
+		UserID:    userID,
+		Key:       apiKeyPrefix + hex.EncodeToString(secret),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err := s.store.ReplaceUserAPIKey(ctx, userID, hashAPIKey(key.Key), key.CreatedAt); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (s *Service) RevokeAPIKeys(ctx context.Context, userID string) (*APIKeyRevocation, error) {
+	user, err := s.store.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrNotFound
+	}
+
+	revokedAt := time.Now().UTC()
+	revoked, err := s.store.RevokeUserAPIKeys(ctx, userID, revokedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &APIKeyRevocation{
+		UserID:    userID,
+		Revoked:   int(revoked),
+		RevokedAt: revokedAt,
+	}, nil
+}
+
+func (s *Service) ResolveAPIKey(ctx context.Context, key string) (string, error) {
+	userID, err := s.store.GetAPIKeyUser(ctx, hashAPIKey(key), time.Now().UTC())
+	if err != nil {
+		return "", err
+	}
+	if userID == "" {
+		return "", ErrInvalidAPIKey
+	}
+	return userID, nil
+}
+
+func (s *Service) UserAPIKeysRequired(ctx context.Context) bool {
+	return s.flags.Enabled(ctx, FlagRequireUserAPIKeys)
+}
+
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
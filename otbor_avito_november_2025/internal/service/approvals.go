@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+func (s *Service) ApprovePR(ctx context.Context, key store.PRKey, userID string) (*PullRequestWithReviewers, error) {
+	pr, err := s.store.GetPR(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if pr == nil {
+		return nil, ErrNotFound
+	}
+	switch pr.Status {
+	case store.PRStatusMerged:
+		return nil, ErrPRMergedApprove
+	case store.PRStatusClosed:
+		return nil, ErrPRClosedApprove
+	}
+
+	ok, err := s.store.ApprovePR(ctx, pr.Key(), userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNotAssigned
+	}
+
+	return s.prWithApprovals(ctx, pr)
+}
+
+func (s *Service) prWithApprovals(ctx context.Context, pr *store.PullRequest) (*PullRequestWithReviewers, error) {
+	reviewers, err := s.store.GetPRReviewers(ctx, pr.Key())
+	if err != nil {
+		return nil, err
+	}
+	approvals, err := s.store.GetPRApprovals(ctx, pr.Key())
+	if err != nil {
+		return nil, err
+	}
+	if approvals == nil {
+		approvals = []store.Approval{}
+	}
+
+	return &PullRequestWithReviewers{
+		PullRequest:       pr,
+		AssignedReviewers: reviewers,
+		Approvals:         approvals,
+	}, nil
+}
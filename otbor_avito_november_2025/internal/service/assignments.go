@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+const defaultAssignmentsLimit = 50
+
+type AssignmentHistory struct {
+	UserID      string
+	Since       time.Time
+	Until       time.Time
+	Total       int
+	Assignments []store.Assignment
+}
+
+func (s *Service) GetUserAssignmentHistory(ctx context.Context, userID string, since, until *time.Time, limit, offset *int) (*AssignmentHistory, error) {
+	from, to, err := resolveWindow(since, until, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := resolveLimit(limit, defaultAssignmentsLimit)
+	if err != nil {
+		return nil, err
+	}
+	skip, err := resolveOffset(offset)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.store.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrNotFound
+	}
+
+	assignments, total, err := s.store.GetUserAssignments(ctx, userID, from, to, n, skip)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AssignmentHistory{
+		UserID:      userID,
+		Since:       from,
+		Until:       to,
+		Total:       total,
+		Assignments: assignments,
+	}, nil
+}
+
+func (s *Service) ExportReviews(ctx context.Context, since, until *time.Time, fn func(store.ReviewExportRow) error) error {
+	from, to, err := resolveWindow(since, until, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	return s.store.StreamReviewExport(ctx, from, to, fn)
+}
+
+func (s *Service) StreamReviewMatrix(ctx context.Context, fn func(store.ReviewMatrixRow) error) error {
+	return s.store.StreamReviewMatrix(ctx, fn)
+}
+
+func resolveWindow(since, until *time.Time, now time.Time) (time.Time, time.Time, error) {
+	from, err := resolveSince(since, now)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	to := now
+	if until != nil {
+		to = until.UTC()
+	}
+	if !to.After(from) {
+		return time.Time{}, time.Time{}, ErrInvalidWindow
+	}
+	return from, to, nil
+}
+
+type PeakLoad struct {
+	UserID string
+	Since  time.Time
+	Peak   int
+	PeakAt *time.Time
+}
+
+func (s *Service) GetPeakLoad(ctx context.Context, userID string, since *time.Time) (*PeakLoad, error) {
+	now := time.Now().UTC()
+	from, err := resolveSince(since, now)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.store.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrNotFound
+	}
+
+	intervals, err := s.store.GetUserReviewIntervals(ctx, userID, from)
+	if err != nil {
+		return nil, err
+	}
+
+	peak, peakAt := sweepPeak(intervals, from, now)
+	return &PeakLoad{
+		UserID: userID,
+		Since:  from,
+		Peak:   peak,
+		PeakAt: peakAt,
+	}, nil
+}
+
+func sweepPeak(intervals []store.ReviewInterval, from, to time.Time) (int, *time.Time) {
+	type event struct {
+		at    time.Time
+		delta int
+	}
+
+	events := make([]event, 0, len(intervals)*2)
+	for _, interval := range intervals {
+		start := interval.Start
+		if start.Before(from) {
+			start = from
+		}
+		end := to
+		if interval.End != nil && interval.End.Before(to) {
+			end = *interval.End
+		}
+		if !end.After(start) {
+			continue
+		}
+		events = append(events, event{at: start, delta: 1}, event{at: end, delta: -1})
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].at.Equal(events[j].at) {
+			return events[i].delta < events[j].delta
+		}
+		return events[i].at.Before(events[j].at)
+	})
+
+	var peak, open int
+	var peakAt *time.Time
+	for i := range events {
+		open += events[i].delta
+		if open > peak {
+			peak = open
+			peakAt = &events[i].at
+		}
+	}
+	return peak, peakAt
+}
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+const (
+	backfillTeam      = "legacy"
+	backfillBatchSize = 500
+)
+
+func (s *Service) BackfillMembership(ctx context.Context) ([]store.PlaceholderUser, error) {
+	return s.store.BackfillAuthors(ctx, backfillTeam, backfillBatchSize)
+}
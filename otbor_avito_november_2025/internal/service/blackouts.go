@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+const (
+	RecurrenceNone   = "none"
+	RecurrenceWeekly = "weekly"
+
+	week = 7 * 24 * time.Hour
+)
+
+func (s *Service) CreateBlackoutWindow(ctx context.Context, teamName string, startsAt, endsAt time.Time, recurrence string) (*store.BlackoutWindow, error) {
+	if recurrence == "" {
+		recurrence = RecurrenceNone
+	}
+	if recurrence != RecurrenceNone && recurrence != RecurrenceWeekly {
+		return nil, ErrInvalidRecurrence
+	}
+	if !endsAt.After(startsAt) || (recurrence == RecurrenceWeekly && endsAt.Sub(startsAt) >= week) {
+		return nil, ErrInvalidBlackout
+	}
+
+	if err := s.requireTeam(ctx, teamName); err != nil {
+		return nil, err
+	}
+
+	window := &store.BlackoutWindow{
+		TeamName:   teamName,
+		StartsAt:   startsAt.UTC(),
+		EndsAt:     endsAt.UTC(),
+		Recurrence: recurrence,
+	}
+
+	existing, err := s.store.GetBlackoutWindows(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range existing {
+		if windowsOverlap(*window, other) {
+			return nil, ErrBlackoutOverlap
+		}
+	}
+
+	if err := s.store.CreateBlackoutWindow(ctx, window); err != nil {
+		return nil, err
+	}
+	return window, nil
+}
+
+func (s *Service) assignmentSuppressed(ctx context.Context, teamName string, now time.Time) (bool, error) {
+	windows, err := s.store.GetBlackoutWindows(ctx, teamName)
+	if err != nil {
+		return false, err
+	}
+	for _, window := range windows {
+		if windowActive(window, now) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func windowActive(w store.BlackoutWindow, now time.Time) bool {
+	if now.Before(w.StartsAt) {
+		return false
+	}
+	if w.Recurrence == RecurrenceWeekly {
+		return now.Sub(w.StartsAt)%week < w.EndsAt.Sub(w.StartsAt)
+	}
+	return now.Before(w.EndsAt)
+}
+
+func windowsOverlap(a, b store.BlackoutWindow) bool {
+	aWeekly := a.Recurrence == RecurrenceWeekly
+	bWeekly := b.Recurrence == RecurrenceWeekly
+
+	switch {
+	case !aWeekly && !bWeekly:
+		return a.StartsAt.Before(b.EndsAt) && b.StartsAt.Before(a.EndsAt)
+	case aWeekly && bWeekly:
+		shift := (b.StartsAt.Sub(a.StartsAt)%week + week) % week
+		return shift < a.EndsAt.Sub(a.StartsAt) || week-shift < b.EndsAt.Sub(b.StartsAt)
+	case aWeekly:
+		return weeklyOverlaps(a, b)
+	default:
+		return weeklyOverlaps(b, a)
+	}
+}
+
+func weeklyOverlaps(weekly, once store.BlackoutWindow) bool {
+	if !once.EndsAt.After(weekly.StartsAt) {
+		return false
+	}
+	if once.EndsAt.Sub(once.StartsAt) >= week {
+		return true
+	}
+
+	duration := weekly.EndsAt.Sub(weekly.StartsAt)
+	k := int64(once.StartsAt.Sub(weekly.StartsAt) / week)
+	for i := k - 1; i <= k+1; i++ {
+		if i < 0 {
+			continue
+		}
+		start := weekly.StartsAt.Add(time.Duration(i) * week)
+		if start.Before(once.EndsAt) && once.StartsAt.Before(start.Add(duration)) {
+			return true
+		}
+	}
+	return false
+}
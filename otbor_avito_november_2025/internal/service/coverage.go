@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+type CoverageGap struct {
+	PullRequest        store.PullRequest
+	Reviewers          int
+	Missing            int
+	EligibleCandidates int
+}
+
+func (s *Service) GetCoverageGaps(ctx context.Context, teamName string) (int, []CoverageGap, error) {
+	if err := s.requireTeam(ctx, teamName); err != nil {
+		return 0, nil, err
+	}
+
+	required, err := s.teamRequiredReviewers(ctx, teamName)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	prs, err := s.store.GetUnderReviewedPRs(ctx, teamName, required)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	activeMembers, err := s.store.GetActiveTeamMembers(ctx, teamName, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	gaps := make([]CoverageGap, 0, len(prs))
+	for _, pr := range prs {
+		reviewers, err := s.store.GetPRReviewers(ctx, pr.PullRequest.Key())
+		if err != nil {
+			return 0, nil, err
+		}
+
+		gaps = append(gaps, CoverageGap{
+			PullRequest:        pr.PullRequest,
+			Reviewers:          pr.Reviewers,
+			Missing:            required - pr.Reviewers,
+			EligibleCandidates: len(eligibleCandidates(activeMembers, pr.PullRequest.AuthorID, reviewers)),
+		})
+	}
+
+	return required, gaps, nil
+}
+
+func eligibleCandidates(members []store.User, authorID string, reviewers []store.User) []store.User {
+	assigned := make(map[string]bool, len(reviewers))
+	for _, reviewer := range reviewers {
+		assigned[reviewer.UserID] = true
+	}
+
+	var eligible []store.User
+	for _, member := range members {
+		if member.UserID != authorID && !assigned[member.UserID] {
+			eligible = append(eligible, member)
+		}
+	}
+	return eligible
+}
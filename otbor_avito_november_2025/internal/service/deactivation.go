@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+type ReviewReassignment struct {
+	PullRequestID string
+	ReplacedBy    string
+}
+
+type OpenReviewHandoff struct {
+	Reassigned  []ReviewReassignment
+	NoCandidate []string
+}
+
+func (s *Service) reassignOpenReviews(ctx context.Context, userID string) (*OpenReviewHandoff, error) {
+	status := store.PRStatusOpen
+	prs, _, err := s.store.GetUserAssignedPRs(ctx, userID, &status, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	handoff := &OpenReviewHandoff{
+		Reassigned:  []ReviewReassignment{},
+		NoCandidate: []string{},
+	}
+	for _, pr := range prs {
+		_, replacedBy, err := s.ReassignReviewer(ctx, pr.Key(), userID)
+		if errors.Is(err, ErrNoCandidate) {
+			handoff.NoCandidate = append(handoff.NoCandidate, pr.PullRequestID)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		handoff.Reassigned = append(handoff.Reassigned, ReviewReassignment{
+			PullRequestID: pr.PullRequestID,
+			ReplacedBy:    replacedBy,
+		})
+	}
+	return handoff, nil
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+type EscalationConfig struct {
+	Interval       time.Duration
+	ExtraReviewers int
+}
+
+func (s *Service) EscalateOverduePRs(ctx context.Context, extraReviewers int) (int, error) {
+	if !s.flags.Enabled(ctx, FlagDeadlineEscalation) {
+		return 0, nil
+	}
+
+	prs, err := s.store.GetOverduePRs(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	escalated := 0
+	for _, pr := range prs {
+		author, err := s.store.GetUser(ctx, pr.AuthorID)
+		if err != nil {
+			return escalated, err
+		}
+		if author == nil {
+			continue
+		}
+
+		currentReviewers, err := s.store.GetPRReviewers(ctx, pr.Key())
+		if err != nil {
+			return escalated, err
+		}
+		activeMembers, err := s.store.GetActiveTeamMembers(ctx, author.TeamName, &pr.AuthorID)
+		if err != nil {
+			return escalated, err
+		}
+
+		selected, err := s.selectReviewers(ctx, AssignmentContext{
+			PullRequestID: pr.PullRequestID,
+			AuthorID:      pr.AuthorID,
+			TeamName:      author.TeamName,
+		}, excludeUsers(activeMembers, currentReviewers), extraReviewers)
+		if err != nil {
+			return escalated, err
+		}
+		if len(selected) == 0 {
+			continue
+		}
+
+		added := false
+		for _, reviewer := range selected {
+			reason := s.assignmentReason(ReasonEscalation, "review deadline "+pr.ReviewDeadline.Format(time.RFC3339)+" passed")
+			_, inserted, err := s.store.AssignReviewer(ctx, pr.Key(), reviewer.UserID, reason)
+			if err != nil {
+				return escalated, err
+			}
+			if !inserted {
+				continue
+			}
+			if err := s.store.RecordEscalation(ctx, pr.Key(), reviewer.UserID); err != nil {
+				return escalated, err
+			}
+			added = true
+		}
+		if added {
+			escalated++
+		}
+	}
+
+	return escalated, nil
+}
+
+type EscalationWorker struct {
+	service *Service
+	config  EscalationConfig
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+func NewEscalationWorker(service *Service, config EscalationConfig) *EscalationWorker {
+	if config.ExtraReviewers <= 0 {
+		config.ExtraReviewers = 1
+	}
+	return &EscalationWorker{service: service, config: config}
+}
+
+func (w *EscalationWorker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.done = make(chan struct{})
+
+	go func() {
+		defer close(w.done)
+		ticker := time.NewTicker(w.config.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				escalated, err := w.service.EscalateOverduePRs(ctx, w.config.ExtraReviewers)
+				if err != nil {
+					log.Println("Failed to escalate overdue PRs:", err)
+					continue
+				}
+				if escalated > 0 {
+					log.Printf("Escalated %d overdue PRs", escalated)
+				}
+			}
+		}
+	}()
+}
+
+func (w *EscalationWorker) Stop() {
+	if w.cancel == nil {
+		return
+	}
+	w.cancel()
+	<-w.done
+}
+
+func excludeUsers(users, excluded []store.User) []store.User {
+	skip := make(map[string]bool, len(excluded))
+	for _, user := range excluded {
+		skip[user.UserID] = true
+	}
+
+	var result []store.User
+	for _, user := range users {
+		if !skip[user.UserID] {
+			result = append(result, user)
+		}
+	}
+	return result
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+func TestEscalateOverduePRs(t *testing.T) {
+	tests := []struct {
+		name    string
+		members []TeamMember
+		approve bool
+		want    int
+	}{
+		{
+			name: "overdue without approvals",
+			members: []TeamMember{
+				{UserID: "u1", Username: "Alice", IsActive: true},
+				{UserID: "u2", Username: "Bob", IsActive: true},
+				{UserID: "u3", Username: "Carol", IsActive: true},
+			},
+			want: 1,
+		},
+		{
+			name: "overdue with enough approvals",
+			members: []TeamMember{
+				{UserID: "u1", Username: "Alice", IsActive: true},
+				{UserID: "u2", Username: "Bob", IsActive: true},
+				{UserID: "u3", Username: "Carol", IsActive: true},
+			},
+			approve: true,
+			want:    0,
+		},
+		{
+			name: "no extra reviewer available",
+			members: []TeamMember{
+				{UserID: "u1", Username: "Alice", IsActive: true},
+				{UserID: "u2", Username: "Bob", IsActive: true},
+			},
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			st := store.NewMemoryStore()
+			flags := NewFeatureFlags(st, map[string]bool{FlagDeadlineEscalation: true})
+			s := NewService(st, WithFeatureFlags(flags))
+
+			required := 1
+			if _, _, err := s.CreateOrUpdateTeam(ctx, "backend", tt.members, &required, nil); err != nil {
+				t.Fatalf("create team: %v", err)
+			}
+			deadline := time.Now().Add(-time.Hour)
+			created, err := s.CreatePR(ctx, "pr-1", "Add search", "u1", CreatePROptions{ReviewDeadline: &deadline})
+			if err != nil {
+				t.Fatalf("create PR: %v", err)
+			}
+			if tt.approve {
+				if _, err := s.ApprovePR(ctx, store.PRKey{PullRequestID: "pr-1"}, created.AssignedReviewers[0].UserID); err != nil {
+					t.Fatalf("approve PR: %v", err)
+				}
+			}
+
+			escalated, err := s.EscalateOverduePRs(ctx, 1)
+			if err != nil {
+				t.Fatalf("EscalateOverduePRs() error = %v", err)
+			}
+			if escalated != tt.want {
+				t.Fatalf("EscalateOverduePRs() = %d, want %d", escalated, tt.want)
+			}
+		})
+	}
+}
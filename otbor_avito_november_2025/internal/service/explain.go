@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+const (
+	ReasonPool            = "pool"
+	ReasonPathOwner       = "path_owner"
+	ReasonRelatedFallback = "related_fallback"
+	ReasonReassignment    = "reassignment"
+	ReasonEscalation      = "escalation"
+	ReasonRebalance       = "rebalance"
+
+	ReasonCrossTeamFallback = "cross_team_fallback"
+)
+
+type AssignmentExplanation struct {
+	Assignment  store.ReviewerAssignment
+	Explanation string
+}
+
+func (s *Service) assignmentReason(reason, detail string) store.AssignmentReason {
+	return store.AssignmentReason{
+		Reason:   reason,
+		Strategy: s.strategy,
+		Detail:   detail,
+	}
+}
+
+func (s *Service) ExplainAssignment(ctx context.Context, key store.PRKey) ([]AssignmentExplanation, error) {
+	pr, err := s.store.GetPR(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if pr == nil {
+		return nil, ErrNotFound
+	}
+
+	assignments, err := s.store.GetPRAssignmentReasons(ctx, pr.Key())
+	if err != nil {
+		return nil, err
+	}
+
+	explanations := make([]AssignmentExplanation, len(assignments))
+	for i, a := range assignments {
+		explanations[i] = AssignmentExplanation{
+			Assignment:  a,
+			Explanation: explain(a),
+		}
+	}
+	return explanations, nil
+}
+
+func explain(a store.ReviewerAssignment) string {
+	var parts []string
+	switch a.Reason.Reason {
+	case ReasonPool:
+		parts = append(parts, "Picked from the reviewer pool")
+	case ReasonPathOwner:
+		parts = append(parts, "Picked as an owner of the changed paths")
+	case ReasonRelatedFallback:
+		parts = append(parts, "Picked although they reviewed the related PR, because no fresh reviewer was available")
+	case ReasonReassignment:
+		parts = append(parts, "Picked as a replacement reviewer")
+	case ReasonEscalation:
+		parts = append(parts, "Added by deadline escalation")
+	case ReasonRebalance:
+		parts = append(parts, "Moved here to even out team review load")
+	default:
+		return "Assigned before assignment reasons were recorded"
+	}
+
+	if a.Reason.Detail != "" {
+		parts = append(parts, "("+a.Reason.Detail+")")
+	}
+	if a.Reason.Strategy != "" {
+		parts = append(parts, "using the "+a.Reason.Strategy+" strategy")
+	}
+
+	text := strings.Join(parts, " ")
+	if a.LoadAtAssignment != nil {
+		text += fmt.Sprintf("; had %d open reviews at the time", *a.LoadAtAssignment)
+	}
+	return text + "."
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+const fairnessTopUsers = 5
+
+type OrgFairness struct {
+	Since           time.Time
+	Users           int
+	Assignments     int
+	MeanAssignments float64
+	Gini            *float64
+	OverAssigned    []store.UserAssignmentCount
+	UnderAssigned   []store.UserAssignmentCount
+}
+
+func (s *Service) GetOrgFairness(ctx context.Context, since *time.Time) (*OrgFairness, error) {
+	from, err := resolveSince(since, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.store.GetActiveUserAssignmentCounts(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+
+	fairness := &OrgFairness{Since: from, Users: len(counts)}
+	for _, count := range counts {
+		fairness.Assignments += count.Assignments
+	}
+	if fairness.Assignments == 0 {
+		return fairness, nil
+	}
+	fairness.MeanAssignments = float64(fairness.Assignments) / float64(len(counts))
+
+	gini := giniCoefficient(counts, fairness.Assignments)
+	fairness.Gini = &gini
+
+	for _, count := range counts {
+		if len(fairness.OverAssigned) == fairnessTopUsers || float64(count.Assignments) <= fairness.MeanAssignments {
+			break
+		}
+		fairness.OverAssigned = append(fairness.OverAssigned, count)
+	}
+	for i := len(counts) - 1; i >= 0; i-- {
+		if len(fairness.UnderAssigned) == fairnessTopUsers || float64(counts[i].Assignments) >= fairness.MeanAssignments {
+			break
+		}
+		fairness.UnderAssigned = append(fairness.UnderAssigned, counts[i])
+	}
+	return fairness, nil
+}
+
+func giniCoefficient(descending []store.UserAssignmentCount, total int) float64 {
+	n := float64(len(descending))
+	var weighted float64
+	for i, count := range descending {
+		rank := n - float64(i)
+		weighted += rank * float64(count.Assignments)
+	}
+	return 2*weighted/(n*float64(total)) - (n+1)/n
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+func (s *Service) GetFallbackTeams(ctx context.Context, teamName string) ([]string, error) {
+	return s.store.GetFallbackTeams(ctx, teamName)
+}
+
+func (s *Service) validateFallbackTeams(ctx context.Context, teamName string, fallbackTeams []string) ([]string, error) {
+	seen := make(map[string]bool, len(fallbackTeams))
+	var unique []string
+	for _, fallback := range fallbackTeams {
+		fallback = strings.TrimSpace(fallback)
+		if fallback == "" || fallback == teamName {
+			return nil, ErrInvalidFallback
+		}
+		if seen[fallback] {
+			continue
+		}
+		team, err := s.store.GetTeam(ctx, fallback)
+		if err != nil {
+			return nil, err
+		}
+		if team == nil {
+			return nil, ErrInvalidFallback
+		}
+		seen[fallback] = true
+		unique = append(unique, fallback)
+	}
+	return unique, nil
+}
+
+func (s *Service) fallbackCandidates(ctx context.Context, teamName string, exclude map[string]bool) ([]store.User, error) {
+	teams, err := s.store.GetFallbackTeams(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []store.User
+	for _, team := range teams {
+		members, err := s.store.GetActiveTeamMembers(ctx, team, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			if !exclude[member.UserID] {
+				candidates = append(candidates, member)
+			}
+		}
+	}
+	return candidates, nil
+}
+
+func (s *Service) fillFromFallbackTeams(ctx context.Context, ac AssignmentContext, reviewers []store.User, reasons map[string]store.AssignmentReason, count int) ([]store.User, error) {
+	if len(reviewers) >= count {
+		return reviewers, nil
+	}
+
+	exclude := map[string]bool{ac.AuthorID: true}
+	for _, reviewer := range reviewers {
+		exclude[reviewer.UserID] = true
+	}
+	candidates, err := s.fallbackCandidates(ctx, ac.TeamName, exclude)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) == 0 {
+		return reviewers, nil
+	}
+
+	extra, err := s.selectReviewers(ctx, ac, candidates, count-len(reviewers))
+	if err != nil {
+		return nil, err
+	}
+	for _, reviewer := range extra {
+		reasons[reviewer.UserID] = s.assignmentReason(ReasonCrossTeamFallback, "member of fallback team "+reviewer.TeamName)
+	}
+	return append(reviewers, extra...), nil
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+const (
+	FlagDeadlineEscalation      = "deadline_escalation"
+	FlagExcludeRelatedReviewers = "exclude_related_reviewers"
+	FlagFastResponderRouting    = "fast_responder_routing"
+	FlagRequireUserAPIKeys      = "require_user_api_keys"
+
+	FlagSourceDefault  = "default"
+	FlagSourceSettings = "settings"
+	FlagSourceEnv      = "env"
+
+	flagsRefreshInterval = 30 * time.Second
+)
+
+var KnownFlags = []string{
+	FlagDeadlineEscalation,
+	FlagExcludeRelatedReviewers,
+	FlagFastResponderRouting,
+	FlagRequireUserAPIKeys,
+}
+
+type FlagState struct {
+	Name    string
+	Enabled bool
+	Source  string
+}
+
+type FeatureFlags struct {
+	store store.Store
+	env   map[string]bool
+
+	mu       sync.RWMutex
+	settings map[string]bool
+	loadedAt time.Time
+}
+
+func NewFeatureFlags(store store.Store, env map[string]bool) *FeatureFlags {
+	return &FeatureFlags{store: store, env: env}
+}
+
+func WithFeatureFlags(flags *FeatureFlags) Option {
+	return func(s *Service) {
+		s.flags = flags
+	}
+}
+
+func (f *FeatureFlags) Enabled(ctx context.Context, name string) bool {
+	if f == nil {
+		return false
+	}
+	return f.state(ctx, name).Enabled
+}
+
+func (f *FeatureFlags) All(ctx context.Context) []FlagState {
+	states := make([]FlagState, len(KnownFlags))
+	for i, name := range KnownFlags {
+		if f == nil {
+			states[i] = FlagState{Name: name, Source: FlagSourceDefault}
+			continue
+		}
+		states[i] = f.state(ctx, name)
+	}
+	return states
+}
+
+func (f *FeatureFlags) state(ctx context.Context, name string) FlagState {
+	if enabled, ok := f.env[name]; ok {
+		return FlagState{Name: name, Enabled: enabled, Source: FlagSourceEnv}
+	}
+
+	f.refresh(ctx)
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if enabled, ok := f.settings[name]; ok {
+		return FlagState{Name: name, Enabled: enabled, Source: FlagSourceSettings}
+	}
+	return FlagState{Name: name, Source: FlagSourceDefault}
+}
+
+func (f *FeatureFlags) refresh(ctx context.Context) {
+	f.mu.RLock()
+	fresh := time.Since(f.loadedAt) < flagsRefreshInterval
+	f.mu.RUnlock()
+	if fresh || f.store == nil {
+		return
+	}
+
+	settings, err := f.store.GetFeatureFlags(ctx)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.loadedAt = time.Now()
+	if err != nil {
+		log.Println("Failed to load feature flags:", err)
+		return
+	}
+	f.settings = settings
+}
+
+func (s *Service) FeatureFlags(ctx context.Context) []FlagState {
+	return s.flags.All(ctx)
+}
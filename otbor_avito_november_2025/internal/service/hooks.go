@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+type AssignmentContext struct {
+	PullRequestID string
+	AuthorID      string
+	TeamName      string
+	ReplacedUser  string
+}
+
+type AssignmentHook interface {
+	BeforeSelect(ctx context.Context, ac AssignmentContext, candidates []store.User) ([]store.User, error)
+	AfterSelect(ctx context.Context, ac AssignmentContext, selected []store.User) ([]store.User, error)
+}
+
+type NoopAssignmentHook struct{}
+
+func (NoopAssignmentHook) BeforeSelect(_ context.Context, _ AssignmentContext, candidates []store.User) ([]store.User, error) {
+	return candidates, nil
+}
+
+func (NoopAssignmentHook) AfterSelect(_ context.Context, _ AssignmentContext, selected []store.User) ([]store.User, error) {
+	return selected, nil
+}
+
+type Option func(*Service)
+
+func WithAssignmentHooks(hooks ...AssignmentHook) Option {
+	return func(s *Service) {
+		s.hooks = append(s.hooks, hooks...)
+	}
+}
+
+func (s *Service) selectReviewers(ctx context.Context, ac AssignmentContext, candidates []store.User, count int) ([]store.User, error) {
+	candidates, err := s.eligibleCandidates(ctx, ac, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	selected, err := s.pick(ctx, candidates, count)
+	if err != nil {
+		return nil, err
+	}
+	return s.afterSelect(ctx, ac, selected)
+}
+
+func (s *Service) afterSelect(ctx context.Context, ac AssignmentContext, selected []store.User) ([]store.User, error) {
+	var err error
+	for _, hook := range s.hooks {
+		selected, err = hook.AfterSelect(ctx, ac, selected)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return selected, nil
+}
+
+func (s *Service) eligibleCandidates(ctx context.Context, ac AssignmentContext, candidates []store.User) ([]store.User, error) {
+	var err error
+	for _, hook := range s.hooks {
+		candidates, err = hook.BeforeSelect(ctx, ac, candidates)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return candidates, nil
+}
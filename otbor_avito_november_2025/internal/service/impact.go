@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+type ReassignImpact struct {
+	PullRequest store.PullRequest
+	Candidates  int
+}
+
+func (s *Service) GetReassignImpact(ctx context.Context, userID string) ([]ReassignImpact, error) {
+	user, err := s.store.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrNotFound
+	}
+
+	open := store.PRStatusOpen
+	prs, _, err := s.store.GetUserAssignedPRs(ctx, userID, &open, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(prs, func(i, j int) bool {
+		if !prs[i].CreatedAt.Equal(prs[j].CreatedAt) {
+			return prs[i].CreatedAt.Before(prs[j].CreatedAt)
+		}
+		return prs[i].PullRequestID < prs[j].PullRequestID
+	})
+
+	var impact []ReassignImpact
+	for i := range prs {
+		pr := &prs[i]
+
+		reviewers, err := s.store.GetPRReviewers(ctx, pr.Key())
+		if err != nil {
+			return nil, err
+		}
+		candidates, err := s.replacementCandidates(ctx, pr, user, reviewers)
+		if err != nil {
+			return nil, err
+		}
+		candidates, err = s.eligibleCandidates(ctx, AssignmentContext{
+			PullRequestID: pr.PullRequestID,
+			AuthorID:      pr.AuthorID,
+			TeamName:      user.TeamName,
+			ReplacedUser:  userID,
+		}, candidates)
+		if err != nil {
+			return nil, err
+		}
+
+		impact = append(impact, ReassignImpact{PullRequest: *pr, Candidates: len(candidates)})
+	}
+	return impact, nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+const metricsNamespace = "pr_review"
+
+type Metrics struct {
+	prsCreated               *prometheus.CounterVec
+	prsMerged                *prometheus.CounterVec
+	reassignments            *prometheus.CounterVec
+	reassignmentsNoCandidate *prometheus.CounterVec
+	storeDuration            *prometheus.HistogramVec
+}
+
+func NewMetrics(registerer prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		prsCreated: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "prs_created_total",
+			Help:      "Pull requests created.",
+		}, []string{"team_name"}),
+		prsMerged: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "prs_merged_total",
+			Help:      "Pull requests merged.",
+		}, []string{"team_name"}),
+		reassignments: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "reassignments_total",
+			Help:      "Reviewer reassignments performed.",
+		}, []string{"team_name"}),
+		reassignmentsNoCandidate: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "reassignments_no_candidate_total",
+			Help:      "Reviewer reassignments that failed because no replacement candidate was available.",
+		}, []string{"team_name"}),
+		storeDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "store_duration_seconds",
+			Help:      "Latency of store method calls.",
+			Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 14),
+		}, []string{"method"}),
+	}
+	registerer.MustRegister(m.prsCreated, m.prsMerged, m.reassignments, m.reassignmentsNoCandidate, m.storeDuration)
+	return m
+}
+
+func WithMetrics(metrics *Metrics) Option {
+	return func(s *Service) {
+		s.metrics = metrics
+		s.store = store.NewInstrumentedStore(s.store, metrics.observeStore)
+	}
+}
+
+func (m *Metrics) observeStore(method string, elapsed time.Duration) {
+	m.storeDuration.WithLabelValues(method).Observe(elapsed.Seconds())
+}
+
+func (m *Metrics) prCreated(teamName string) {
+	if m == nil {
+		return
+	}
+	m.prsCreated.WithLabelValues(teamName).Inc()
+}
+
+func (m *Metrics) prMerged(teamName string) {
+	if m == nil {
+		return
+	}
+	m.prsMerged.WithLabelValues(teamName).Inc()
+}
+
+func (m *Metrics) reassigned(teamName string) {
+	if m == nil {
+		return
+	}
+	m.reassignments.WithLabelValues(teamName).Inc()
+}
+
+func (m *Metrics) reassignNoCandidate(teamName string) {
+	if m == nil {
+		return
+	}
+	m.reassignmentsNoCandidate.WithLabelValues(teamName).Inc()
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+type OwnershipRule struct {
+	Pattern string
+	Owners  []string
+}
+
+func (s *Service) SetPathOwnership(ctx context.Context, teamName string, rules []OwnershipRule) ([]OwnershipRule, error) {
+	if err := s.requireTeam(ctx, teamName); err != nil {
+		return nil, err
+	}
+
+	members, err := s.store.GetTeamMembers(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	inTeam := make(map[string]bool, len(members))
+	for _, member := range members {
+		inTeam[member.UserID] = true
+	}
+
+	var owners []store.PathOwner
+	for _, rule := range rules {
+		if !validPattern(rule.Pattern) {
+			return nil, ErrInvalidPattern
+		}
+		for _, userID := range rule.Owners {
+			if !inTeam[userID] {
+				return nil, ErrOwnerNotInTeam
+			}
+			owners = append(owners, store.PathOwner{Pattern: rule.Pattern, UserID: userID})
+		}
+	}
+
+	if err := s.store.ReplacePathOwners(ctx, teamName, owners); err != nil {
+		return nil, err
+	}
+
+	stored, err := s.store.GetPathOwners(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	return groupOwners(stored), nil
+}
+
+func (s *Service) splitByOwnership(ctx context.Context, teamName string, candidates []store.User, paths []string) ([]store.User, []store.User, map[string][]string, error) {
+	rules, err := s.store.GetPathOwners(ctx, teamName)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	patterns := make(map[string][]string)
+	for _, rule := range rules {
+		for _, p := range paths {
+			if matchPattern(rule.Pattern, p) {
+				patterns[rule.UserID] = append(patterns[rule.UserID], rule.Pattern)
+				break
+			}
+		}
+	}
+
+	var matched, rest []store.User
+	for _, candidate := range candidates {
+		if len(patterns[candidate.UserID]) > 0 {
+			matched = append(matched, candidate)
+		} else {
+			rest = append(rest, candidate)
+		}
+	}
+	return matched, rest, patterns, nil
+}
+
+func groupOwners(owners []store.PathOwner) []OwnershipRule {
+	var rules []OwnershipRule
+	for _, owner := range owners {
+		if n := len(rules); n > 0 && rules[n-1].Pattern == owner.Pattern {
+			rules[n-1].Owners = append(rules[n-1].Owners, owner.UserID)
+			continue
+		}
+		rules = append(rules, OwnershipRule{Pattern: owner.Pattern, Owners: []string{owner.UserID}})
+	}
+	return rules
+}
+
+func validPattern(pattern string) bool {
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" {
+		return false
+	}
+	_, err := path.Match(strings.TrimSuffix(strings.TrimPrefix(pattern, "/"), "/**"), "")
+	return err == nil
+}
+
+func matchPattern(pattern, file string) bool {
+	pattern = strings.TrimPrefix(pattern, "/")
+	file = strings.TrimPrefix(file, "/")
+
+	if dir := strings.TrimSuffix(strings.TrimSuffix(pattern, "**"), "/"); dir != pattern {
+		return strings.HasPrefix(file, dir+"/")
+	}
+	if !strings.Contains(pattern, "/") {
+		ok, _ := path.Match(pattern, path.Base(file))
+		return ok
+	}
+	ok, _ := path.Match(pattern, file)
+	return ok
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+type PoolTrend struct {
+	TeamName string
+	Bucket   string
+	Since    time.Time
+	Points   []store.PoolPoint
+}
+
+func (s *Service) GetPoolTrend(ctx context.Context, teamName string, since *time.Time, bucket string) (*PoolTrend, error) {
+	if bucket == "" {
+		bucket = BucketDay
+	}
+	if bucket != BucketDay && bucket != BucketWeek {
+		return nil, ErrInvalidBucket
+	}
+
+	from, err := resolveSince(since, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	from = truncateToBucket(from, bucket)
+
+	if err := s.requireTeam(ctx, teamName); err != nil {
+		return nil, err
+	}
+
+	points, err := s.store.GetPoolTrend(ctx, teamName, bucket, from)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PoolTrend{
+		TeamName: teamName,
+		Bucket:   bucket,
+		Since:    from,
+		Points:   points,
+	}, nil
+}
+
+func (s *Service) SnapshotReviewerPools(ctx context.Context) (int64, error) {
+	return s.store.RecordPoolSnapshots(ctx, time.Now().UTC(), busyReviewThreshold)
+}
+
+type PoolSnapshotWorker struct {
+	service  *Service
+	interval time.Duration
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+func NewPoolSnapshotWorker(service *Service, interval time.Duration) *PoolSnapshotWorker {
+	return &PoolSnapshotWorker{service: service, interval: interval}
+}
+
+func (w *PoolSnapshotWorker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.done = make(chan struct{})
+
+	go func() {
+		defer close(w.done)
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := w.service.SnapshotReviewerPools(ctx); err != nil {
+				log.Println("Failed to snapshot reviewer pools:", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (w *PoolSnapshotWorker) Stop() {
+	if w.cancel == nil {
+		return
+	}
+	w.cancel()
+	<-w.done
+}
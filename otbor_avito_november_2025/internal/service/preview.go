@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+type TeamSettings struct {
+	Strategy          string
+	RequiredReviewers int
+}
+
+type AssignmentPreview struct {
+	PullRequest       store.PullRequest
+	CurrentReviewers  []store.User
+	ProposedReviewers []store.User
+	Unfilled          int
+}
+
+func (s *Service) PreviewTeamSettings(ctx context.Context, teamName string, strategy *string, requiredReviewers *int) (TeamSettings, []AssignmentPreview, error) {
+	settings := TeamSettings{
+		Strategy:          s.strategy,
+		RequiredReviewers: defaultRequiredReviewers,
+	}
+	if strategy != nil {
+		if *strategy != StrategyRandom && *strategy != StrategyWeighted && *strategy != StrategyLeastLoaded {
+			return settings, nil, ErrInvalidStrategy
+		}
+		settings.Strategy = *strategy
+	}
+	if requiredReviewers != nil {
+		if *requiredReviewers < 1 {
+			return settings, nil, ErrInvalidRequired
+		}
+		settings.RequiredReviewers = *requiredReviewers
+	}
+
+	if err := s.requireTeam(ctx, teamName); err != nil {
+		return settings, nil, err
+	}
+	if requiredReviewers == nil {
+		required, err := s.teamRequiredReviewers(ctx, teamName)
+		if err != nil {
+			return settings, nil, err
+		}
+		settings.RequiredReviewers = required
+	}
+
+	prs, err := s.store.GetUnderReviewedPRs(ctx, teamName, settings.RequiredReviewers)
+	if err != nil {
+		return settings, nil, err
+	}
+
+	activeMembers, err := s.store.GetActiveTeamMembers(ctx, teamName, nil)
+	if err != nil {
+		return settings, nil, err
+	}
+
+	previews := make([]AssignmentPreview, 0, len(prs))
+	for _, pr := range prs {
+		reviewers, err := s.store.GetPRReviewers(ctx, pr.PullRequest.Key())
+		if err != nil {
+			return settings, nil, err
+		}
+
+		missing := settings.RequiredReviewers - pr.Reviewers
+		candidates := eligibleCandidates(activeMembers, pr.PullRequest.AuthorID, reviewers)
+		proposed, err := s.pickWithStrategy(ctx, settings.Strategy, candidates, missing)
+		if err != nil {
+			return settings, nil, err
+		}
+
+		previews = append(previews, AssignmentPreview{
+			PullRequest:       pr.PullRequest,
+			CurrentReviewers:  reviewers,
+			ProposedReviewers: proposed,
+			Unfilled:          missing - len(proposed),
+		})
+	}
+
+	return settings, previews, nil
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+func (s *Service) SetUserQuota(ctx context.Context, userID string, quota *int) (*store.User, error) {
+	if quota != nil && *quota < 0 {
+		return nil, ErrInvalidQuota
+	}
+
+	user, err := s.store.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrNotFound
+	}
+
+	if err := s.store.SetUserWeeklyQuota(ctx, userID, quota); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *Service) SetTeamQuota(ctx context.Context, teamName string, quota *int) error {
+	if quota != nil && *quota < 0 {
+		return ErrInvalidQuota
+	}
+
+	if err := s.requireTeam(ctx, teamName); err != nil {
+		return err
+	}
+
+	return s.store.SetTeamDefaultWeeklyQuota(ctx, teamName, quota)
+}
+
+func (s *Service) withinQuota(ctx context.Context, candidates []store.User, now time.Time) ([]store.User, bool, error) {
+	if len(candidates) == 0 {
+		return candidates, false, nil
+	}
+
+	ids := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		ids[i] = candidate.UserID
+	}
+
+	usage, err := s.store.GetWeeklyQuotaUsage(ctx, ids, truncateToBucket(now, BucketWeek))
+	if err != nil {
+		return nil, false, err
+	}
+
+	var eligible []store.User
+	for _, candidate := range candidates {
+		if u, ok := usage[candidate.UserID]; ok && u.Assigned >= u.Quota {
+			continue
+		}
+		eligible = append(eligible, candidate)
+	}
+
+	if len(eligible) == 0 {
+		return candidates, true, nil
+	}
+	return eligible, false, nil
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+const defaultRebalanceDeviation = 1
+
+type RebalanceSwap struct {
+	TeamName      string
+	PullRequestID string
+	FromUserID    string
+	ToUserID      string
+}
+
+func (swap RebalanceSwap) key() store.PRKey {
+	return store.PRKey{TeamName: swap.TeamName, PullRequestID: swap.PullRequestID}
+}
+
+func (s *Service) RebalanceTeam(ctx context.Context, teamName string, maxDeviation *int) ([]RebalanceSwap, error) {
+	deviation := defaultRebalanceDeviation
+	if maxDeviation != nil {
+		if *maxDeviation < 1 {
+			return nil, ErrInvalidDeviation
+		}
+		deviation = *maxDeviation
+	}
+
+	if err := s.requireTeam(ctx, teamName); err != nil {
+		return nil, err
+	}
+
+	members, err := s.store.GetActiveTeamMembers(ctx, teamName, nil)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := s.store.GetOpenReviewCounts(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	assignments, err := s.store.GetTeamOpenAssignments(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	load := make(map[string]int, len(members))
+	for _, member := range members {
+		load[member.UserID] = counts[member.UserID]
+	}
+
+	reviewers := make(map[store.PRKey]map[string]bool)
+	movable := make(map[string][]store.OpenAssignment)
+	for _, a := range assignments {
+		if reviewers[a.Key()] == nil {
+			reviewers[a.Key()] = make(map[string]bool)
+		}
+		reviewers[a.Key()][a.UserID] = true
+		if _, active := load[a.UserID]; active && !a.Acknowledged && !a.Approved {
+			movable[a.UserID] = append(movable[a.UserID], a)
+		}
+	}
+
+	var swaps []RebalanceSwap
+	for {
+		swap, index, ok := nextRebalanceSwap(load, movable, reviewers, deviation)
+		if !ok {
+			break
+		}
+		movable[swap.FromUserID] = append(movable[swap.FromUserID][:index], movable[swap.FromUserID][index+1:]...)
+
+		reason := s.assignmentReason(ReasonRebalance, "moved from "+swap.FromUserID)
+		moved, err := s.store.SwapReviewer(ctx, swap.key(), swap.FromUserID, swap.ToUserID, reason)
+		if err != nil {
+			return nil, err
+		}
+		if !moved {
+			continue
+		}
+
+		load[swap.FromUserID]--
+		load[swap.ToUserID]++
+		delete(reviewers[swap.key()], swap.FromUserID)
+		reviewers[swap.key()][swap.ToUserID] = true
+		swaps = append(swaps, swap)
+	}
+
+	return swaps, nil
+}
+
+func nextRebalanceSwap(load map[string]int, movable map[string][]store.OpenAssignment, reviewers map[store.PRKey]map[string]bool, deviation int) (RebalanceSwap, int, bool) {
+	userIDs := make([]string, 0, len(load))
+	for userID := range load {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Slice(userIDs, func(i, j int) bool {
+		if load[userIDs[i]] == load[userIDs[j]] {
+			return userIDs[i] < userIDs[j]
+		}
+		return load[userIDs[i]] < load[userIDs[j]]
+	})
+
+	for i := len(userIDs) - 1; i >= 0; i-- {
+		from := userIDs[i]
+		for _, to := range userIDs {
+			if load[from]-load[to] <= deviation {
+				break
+			}
+			for index, a := range movable[from] {
+				if a.AuthorID != to && !reviewers[a.Key()][to] {
+					return RebalanceSwap{TeamName: a.TeamName, PullRequestID: a.PullRequestID, FromUserID: from, ToUserID: to}, index, true
+				}
+			}
+		}
+	}
+	return RebalanceSwap{}, 0, false
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+func TestRebalanceTeamSkipsApprovedReviewers(t *testing.T) {
+	tests := []struct {
+		name      string
+		approvers []string
+		wantMoved bool
+	}{
+		{name: "nothing approved", wantMoved: true},
+		{name: "one reviewer approved", approvers: []string{"u2"}, wantMoved: true},
+		{name: "all reviewers approved", approvers: []string{"u2", "u3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestService(t, []TeamMember{
+				{UserID: "u1", Username: "Alice", IsActive: true},
+				{UserID: "u2", Username: "Bob", IsActive: true},
+				{UserID: "u3", Username: "Carol", IsActive: true},
+				{UserID: "u4", Username: "Dave", IsActive: false},
+			})
+			for i := 1; i <= 3; i++ {
+				prID := fmt.Sprintf("pr-%d", i)
+				if _, err := s.CreatePR(ctx, prID, "Add search", "u1", CreatePROptions{}); err != nil {
+					t.Fatalf("create %s: %v", prID, err)
+				}
+				for _, approver := range tt.approvers {
+					if _, err := s.ApprovePR(ctx, store.PRKey{PullRequestID: prID}, approver); err != nil {
+						t.Fatalf("approve %s: %v", prID, err)
+					}
+				}
+			}
+			if _, _, err := s.SetUserActive(ctx, "u4", true, false); err != nil {
+				t.Fatalf("activate u4: %v", err)
+			}
+
+			swaps, err := s.RebalanceTeam(ctx, "backend", nil)
+			if err != nil {
+				t.Fatalf("RebalanceTeam() error = %v", err)
+			}
+			if moved := len(swaps) > 0; moved != tt.wantMoved {
+				t.Fatalf("RebalanceTeam() swaps = %v, want moved %v", swaps, tt.wantMoved)
+			}
+			for _, swap := range swaps {
+				for _, approver := range tt.approvers {
+					if swap.FromUserID == approver {
+						t.Fatalf("RebalanceTeam() moved %s off %s after approval", approver, swap.PullRequestID)
+					}
+				}
+			}
+		})
+	}
+}
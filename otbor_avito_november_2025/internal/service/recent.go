@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+const defaultRecentReviewerWindow = 3
+
+func WithRecentReviewerWindow(prs int) Option {
+	return func(s *Service) {
+		if prs >= 0 {
+			s.recentReviewerWindow = prs
+		}
+	}
+}
+
+func (s *Service) selectAvoidingRecent(ctx context.Context, ac AssignmentContext, candidates []store.User, count int) ([]store.User, error) {
+	if s.recentReviewerWindow == 0 {
+		return s.selectReviewers(ctx, ac, candidates, count)
+	}
+
+	recent, err := s.store.GetRecentAuthorReviewers(ctx, ac.AuthorID, s.recentReviewerWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := make([]store.User, len(recent))
+	for i, userID := range recent {
+		previous[i] = store.User{UserID: userID}
+	}
+	fresh, seen, reviewed := splitByPrevious(candidates, previous)
+
+	return s.selectPreferringFresh(ctx, ac, append(fresh, seen...), reviewed, count)
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+type recordingHook struct {
+	NoopAssignmentHook
+	calls [][]string
+}
+
+func (h *recordingHook) BeforeSelect(_ context.Context, _ AssignmentContext, candidates []store.User) ([]store.User, error) {
+	ids := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		ids[i] = candidate.UserID
+	}
+	h.calls = append(h.calls, ids)
+	return candidates, nil
+}
+
+func TestSelectAvoidingRecentRunsHooksOnce(t *testing.T) {
+	ctx := context.Background()
+	hook := &recordingHook{}
+	s := NewService(store.NewMemoryStore(), WithAssignmentHooks(hook))
+	members := []TeamMember{
+		{UserID: "u1", Username: "Alice", IsActive: true},
+		{UserID: "u2", Username: "Bob", IsActive: true},
+		{UserID: "u3", Username: "Carol", IsActive: true},
+		{UserID: "u4", Username: "Dave", IsActive: true},
+	}
+	if _, _, err := s.CreateOrUpdateTeam(ctx, "backend", members, nil, nil); err != nil {
+		t.Fatalf("create team: %v", err)
+	}
+
+	first, err := s.CreatePR(ctx, "pr-1", "Add search", "u1", CreatePROptions{})
+	if err != nil {
+		t.Fatalf("create PR: %v", err)
+	}
+	previous := make(map[string]bool)
+	for _, id := range reviewerIDs(first.AssignedReviewers) {
+		previous[id] = true
+	}
+
+	hook.calls = nil
+	second, err := s.CreatePR(ctx, "pr-2", "Fix search", "u1", CreatePROptions{})
+	if err != nil {
+		t.Fatalf("create PR: %v", err)
+	}
+
+	if len(hook.calls) != 1 {
+		t.Fatalf("BeforeSelect called %d times, want 1", len(hook.calls))
+	}
+	candidates := hook.calls[0]
+	if len(candidates) != 3 {
+		t.Fatalf("BeforeSelect candidates = %v, want all 3 reviewers", candidates)
+	}
+	if previous[candidates[0]] {
+		t.Fatalf("BeforeSelect candidates = %v, want fresh reviewer first", candidates)
+	}
+
+	fresh := 0
+	for _, id := range reviewerIDs(second.AssignedReviewers) {
+		if !previous[id] {
+			fresh++
+		}
+	}
+	if fresh != 1 {
+		t.Fatalf("second PR reviewers = %v, want the fresh reviewer included", reviewerIDs(second.AssignedReviewers))
+	}
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+func (s *Service) selectFreshReviewers(ctx context.Context, ac AssignmentContext, candidates []store.User, relatedPRID string, count int) ([]store.User, map[string]bool, error) {
+	related, err := s.store.GetPR(ctx, store.PRKey{PullRequestID: relatedPRID})
+	if err != nil {
+		return nil, nil, err
+	}
+	if related == nil {
+		return nil, nil, ErrNotFound
+	}
+
+	previous, err := s.store.GetPRReviewers(ctx, related.Key())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fresh, seen, reviewed := splitByPrevious(candidates, previous)
+
+	if s.flags.Enabled(ctx, FlagExcludeRelatedReviewers) {
+		pool := fresh
+		if len(fresh) == 0 {
+			pool = candidates
+		}
+		selected, err := s.selectReviewers(ctx, ac, pool, count)
+		return selected, reviewed, err
+	}
+
+	selected, err := s.selectPreferringFresh(ctx, ac, append(fresh, seen...), reviewed, count)
+	if err != nil {
+		return nil, nil, err
+	}
+	return selected, reviewed, nil
+}
+
+// selectPreferringFresh runs the hooks and the strategy once over candidates
+// ordered fresh first, then fills the selection with fresh candidates before
+// falling back to ones that already reviewed.
+func (s *Service) selectPreferringFresh(ctx context.Context, ac AssignmentContext, candidates []store.User, reviewed map[string]bool, count int) ([]store.User, error) {
+	candidates, err := s.eligibleCandidates(ctx, ac, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	ranked, err := s.pick(ctx, candidates, len(candidates))
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return !reviewed[ranked[i].UserID] && reviewed[ranked[j].UserID]
+	})
+	return s.afterSelect(ctx, ac, ranked[:min(count, len(ranked))])
+}
+
+func splitByPrevious(candidates, previous []store.User) ([]store.User, []store.User, map[string]bool) {
+	reviewed := make(map[string]bool, len(previous))
+	for _, user := range previous {
+		reviewed[user.UserID] = true
+	}
+
+	var fresh, seen []store.User
+	for _, user := range candidates {
+		if reviewed[user.UserID] {
+			seen = append(seen, user)
+		} else {
+			fresh = append(fresh, user)
+		}
+	}
+	return fresh, seen, reviewed
+}
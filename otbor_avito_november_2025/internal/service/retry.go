@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+type AssignmentRetryConfig struct {
+	Window   time.Duration
+	Attempts int
+}
+
+func (c AssignmentRetryConfig) Enabled() bool {
+	return c.Window > 0 && c.Attempts > 0
+}
+
+func (c AssignmentRetryConfig) Interval() time.Duration {
+	if c.Attempts <= 0 {
+		return c.Window
+	}
+	return c.Window / time.Duration(c.Attempts)
+}
+
+func WithAssignmentRetry(config AssignmentRetryConfig) Option {
+	return func(s *Service) {
+		s.retry = config
+	}
+}
+
+func (s *Service) AssignmentRetry() AssignmentRetryConfig {
+	return s.retry
+}
+
+// enqueueAssignmentRetry schedules another assignment attempt for a PR that
+// was created without reviewers. The PR is already committed by then, so a
+// failure is logged rather than returned and the PR is reported as not pending.
+func (s *Service) enqueueAssignmentRetry(ctx context.Context, key store.PRKey, now time.Time) bool {
+	if !s.retry.Enabled() {
+		return false
+	}
+	if err := s.store.EnqueuePendingAssignment(ctx, key, now.Add(s.retry.Interval()), now.Add(s.retry.Window)); err != nil {
+		log.Printf("Failed to enqueue reviewer assignment retry for PR %s: %v", key, err)
+		return false
+	}
+	return true
+}
+
+func (s *Service) RetryPendingAssignments(ctx context.Context) (int, error) {
+	now := time.Now()
+	pending, err := s.store.GetDuePendingAssignments(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	assigned := 0
+	for _, p := range pending {
+		reviewers, _, err := s.processPendingAssignment(ctx, p, now)
+		if err != nil {
+			return assigned, err
+		}
+		if reviewers > 0 {
+			assigned++
+		}
+	}
+
+	return assigned, nil
+}
+
+func (s *Service) GetAssignmentQueue(ctx context.Context) ([]store.PendingAssignment, error) {
+	return s.store.GetPendingAssignments(ctx)
+}
+
+func (s *Service) RetryPendingAssignment(ctx context.Context, key store.PRKey) (*PullRequestWithReviewers, error) {
+	pr, err := s.store.GetPR(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if pr == nil {
+		return nil, ErrNotFound
+	}
+	p, err := s.store.GetPendingAssignment(ctx, pr.Key())
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, ErrNotFound
+	}
+
+	_, queued, err := s.processPendingAssignment(ctx, *p, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err = s.store.GetPR(ctx, pr.Key())
+	if err != nil {
+		return nil, err
+	}
+	if pr == nil {
+		return nil, ErrNotFound
+	}
+	reviewers, err := s.store.GetPRReviewers(ctx, pr.Key())
+	if err != nil {
+		return nil, err
+	}
+
+	return &PullRequestWithReviewers{
+		PullRequest:       pr,
+		AssignedReviewers: reviewers,
+		AssignmentPending: queued,
+	}, nil
+}
+
+func (s *Service) processPendingAssignment(ctx context.Context, p store.PendingAssignment, now time.Time) (int, bool, error) {
+	reviewers, done, err := s.retryAssignment(ctx, p.Key(), now)
+	if err != nil {
+		return 0, false, err
+	}
+	if done {
+		return reviewers, false, nil
+	}
+
+	next := now.Add(s.retry.Interval())
+	if p.Attempts+1 >= s.retry.Attempts || next.After(p.ExpiresAt) {
+		log.Printf("Giving up assigning reviewers to PR %s after %d attempts", p.Key(), p.Attempts+1)
+		return 0, false, s.store.DeletePendingAssignment(ctx, p.Key())
+	}
+	return 0, true, s.store.ReschedulePendingAssignment(ctx, p.Key(), next)
+}
+
+func (s *Service) retryAssignment(ctx context.Context, key store.PRKey, now time.Time) (int, bool, error) {
+	pr, err := s.store.GetPR(ctx, key)
+	if err != nil {
+		return 0, false, err
+	}
+	if pr == nil || pr.Status != store.PRStatusOpen {
+		return 0, true, s.store.DeletePendingAssignment(ctx, key)
+	}
+
+	current, err := s.store.GetPRReviewers(ctx, key)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(current) > 0 {
+		return 0, true, s.store.DeletePendingAssignment(ctx, key)
+	}
+
+	author, err := s.store.GetUser(ctx, pr.AuthorID)
+	if err != nil {
+		return 0, false, err
+	}
+	if author == nil {
+		return 0, true, s.store.DeletePendingAssignment(ctx, key)
+	}
+
+	suppressed, err := s.assignmentSuppressed(ctx, author.TeamName, now)
+	if err != nil {
+		return 0, false, err
+	}
+	if suppressed {
+		return 0, false, nil
+	}
+
+	activeMembers, err := s.store.GetActiveTeamMembers(ctx, author.TeamName, &pr.AuthorID)
+	if err != nil {
+		return 0, false, err
+	}
+	candidates, _, err := s.withinQuota(ctx, activeMembers, now.UTC())
+	if err != nil {
+		return 0, false, err
+	}
+	required, err := s.teamRequiredReviewers(ctx, author.TeamName)
+	if err != nil {
+		return 0, false, err
+	}
+
+	ac := AssignmentContext{
+		PullRequestID: key.PullRequestID,
+		AuthorID:      pr.AuthorID,
+		TeamName:      author.TeamName,
+	}
+	reviewers, reasons, err := s.assignReviewers(ctx, ac, candidates, CreatePROptions{}, required)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(reviewers) == 0 {
+		return 0, false, nil
+	}
+
+	assigned := 0
+	for _, reviewer := range reviewers {
+		_, inserted, err := s.store.AssignReviewer(ctx, key, reviewer.UserID, reasons[reviewer.UserID])
+		if err != nil {
+			return 0, false, err
+		}
+		if inserted {
+			assigned++
+		}
+	}
+	return assigned, true, s.store.DeletePendingAssignment(ctx, key)
+}
+
+type AssignmentRetryWorker struct {
+	service *Service
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+func NewAssignmentRetryWorker(service *Service) *AssignmentRetryWorker {
+	return &AssignmentRetryWorker{service: service}
+}
+
+func (w *AssignmentRetryWorker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.done = make(chan struct{})
+
+	go func() {
+		defer close(w.done)
+		ticker := time.NewTicker(w.service.retry.Interval())
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				assigned, err := w.service.RetryPendingAssignments(ctx)
+				if err != nil {
+					log.Println("Failed to retry pending assignments:", err)
+					continue
+				}
+				if assigned > 0 {
+					log.Printf("Assigned reviewers to %d pending PRs", assigned)
+				}
+			}
+		}
+	}()
+}
+
+func (w *AssignmentRetryWorker) Stop() {
+	if w.cancel == nil {
+		return
+	}
+	w.cancel()
+	<-w.done
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+type failingEnqueueStore struct {
+	store.Store
+}
+
+func (failingEnqueueStore) EnqueuePendingAssignment(context.Context, store.PRKey, time.Time, time.Time) error {
+	return errors.New("enqueue failed")
+}
+
+func TestCreatePREnqueueFailure(t *testing.T) {
+	ctx := context.Background()
+	st := failingEnqueueStore{Store: store.NewMemoryStore()}
+	s := NewService(st, WithAssignmentRetry(AssignmentRetryConfig{Window: time.Hour, Attempts: 3}))
+	if _, _, err := s.CreateOrUpdateTeam(ctx, "backend", []TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}}, nil, nil); err != nil {
+		t.Fatalf("create team: %v", err)
+	}
+
+	result, err := s.CreatePR(ctx, "pr-1", "Add search", "u1", CreatePROptions{})
+	if err != nil {
+		t.Fatalf("CreatePR() error = %v, want the created PR", err)
+	}
+	if result.AssignmentPending {
+		t.Fatalf("CreatePR() reported the assignment as pending")
+	}
+	if pr, err := st.GetPR(ctx, store.PRKey{PullRequestID: "pr-1"}); err != nil || pr == nil {
+		t.Fatalf("get PR: %v, %v", pr, err)
+	}
+}
@@ -1,320 +1,817 @@
-package service
-
-import (
-	"context"
-	"errors"
-	"math/rand"
-	"time"
-
-	"otbor_avito_november_2025/internal/store"
-)
-
-var (
-	ErrTeamExists  = errors.New("team_name already exists")
-	ErrPRExists    = errors.New("PR id already exists")
-	ErrPRMerged    = errors.New("cannot reassign on merged PR")
-	ErrNotAssigned = errors.New("reviewer is not assigned to this PR")
-	ErrNoCandidate = errors.New("no active replacement candidate in team")
-	ErrNotFound    = errors.New("resource not found")
-)
-
-type TeamMember struct {
-	UserID   string
-	Username string
-	IsActive bool
-}
-
-type PullRequestWithReviewers struct {
-	PullRequest       *store.PullRequest
-	AssignedReviewers []store.User
-}
-
-type Service struct {
-	store *store.PostgresStore
-}
-
-func NewService(store *store.PostgresStore) *Service {
-	rand.Seed(time.Now().UnixNano())
-	return &Service{store: store}
-}
-
-func (s *Service) CreateOrUpdateTeam(ctx context.Context, teamName string, members []TeamMember) (*store.Team, error) {
-	existingTeam, err := s.store.GetTeam(ctx, teamName)
-	if err == nil && existingTeam != nil {
-		return nil, ErrTeamExists
-	}
-
-	team := &store.Team{Name: teamName}
-	if err := s.store.CreateTeam(ctx, team); err != nil {
-		return nil, err
-	}
-
-	for _, member := range members {
-		user := &store.User{
-			UserID:   member.UserID,
-			Username: member.Username,
-			IsActive: member.IsActive,
-			TeamName: teamName,
-		}
-		if err := s.store.CreateOrUpdateUser(ctx, user); err != nil {
-			return nil, err
-		}
-	}
-
-	return team, nil
-}
-
-func (s *Service) GetTeam(ctx context.Context, teamName string) (*store.Team, []store.User, error) {
-	team, err := s.store.GetTeam(ctx, teamName)
-	if err != nil {
-		return nil, nil, err
-	}
-	if team == nil {
-		return nil, nil, ErrNotFound
-	}
-
-	members, err := s.store.GetTeamMembers(ctx, teamName)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return team, members, nil
-}
-
-func (s *Service) SetUserActive(ctx context.Context, userID string, isActive bool) (*store.User, error) {
-	user, err := s.store.GetUser(ctx, userID)
-	if err != nil {
-		return nil, err
-	}
-
-	user.IsActive = isActive
-	if err := s.store.UpdateUser(ctx, user); err != nil {
-		return nil, err
-	}
-
-	return user, nil
-}
-
-func (s *Service) CreatePR(ctx context.Context, prID, prName, authorID string) (*PullRequestWithReviewers, error) {
-	existingPR, err := s.store.GetPR(ctx, prID)
-	if err != nil {
-		return nil, err
-	}
-	if existingPR != nil {
-		return nil, ErrPRExists
-	}
-
-	author, err := s.store.GetUser(ctx, authorID)
-	if err != nil {
-		return nil, err
-	}
-	if author == nil {
-		return nil, ErrNotFound
-	}
-
-	activeMembers, err := s.store.GetActiveTeamMembers(ctx, author.TeamName, &authorID)
-	if err != nil {
-		return nil, err
-	}
-
-	var reviewers []store.User
-	if len(activeMembers) > 0 {
-		count := min(2, len(activeMembers))
-		shuffled := make([]store.User, len(activeMembers))
-		copy(shuffled, activeMembers)
-		rand.Shuffle(len(shuffled), func(i, j int) {
-			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
-		})
-		reviewers = shuffled[:count]
-	}
-
-	pr := &store.PullRequest{
-		PullRequestID:   prID,
-		PullRequestName: prName,
-		AuthorID:        authorID,
-		Status:          store.PRStatusOpen,
-		CreatedAt:       time.Now(),
-	}
-
-	if err := s.store.CreatePR(ctx, pr); err != nil {
-		return nil, err
-	}
-
-	for _, reviewer := range reviewers {
-		if err := s.store.AssignReviewer(ctx, prID, reviewer.UserID); err != nil {
-			return nil, err
-		}
-	}
-
-	return &PullRequestWithReviewers{
-		PullRequest:       pr,
-		AssignedReviewers: reviewers,
-	}, nil
-}
-
-func (s *Service) MergePR(ctx context.Context, prID string) (*PullRequestWithReviewers, error) {
-	pr, err := s.store.GetPR(ctx, prID)
-	if err != nil {
-		return nil, err
-	}
-	if pr == nil {
-		return nil, ErrNotFound
-	}
-
-	if pr.Status == store.PRStatusMerged {
-		reviewers, err := s.store.GetPRReviewers(ctx, prID)
-		if err != nil {
-			return nil, err
-		}
-		return &PullRequestWithReviewers{
-			PullRequest:       pr,
-			AssignedReviewers: reviewers,
-		}, nil
-	}
-
-	now := time.Now()
-	pr.Status = store.PRStatusMerged
-	pr.MergedAt = &now
-
-	if err := s.store.UpdatePR(ctx, pr); err != nil {
-		return nil, err
-	}
-
-	reviewers, err := s.store.GetPRReviewers(ctx, prID)
-	if err != nil {
-		return nil, err
-	}
-
-	return &PullRequestWithReviewers{
-		PullRequest:       pr,
-		AssignedReviewers: reviewers,
-	}, nil
-}
-
-func (s *Service) ReassignReviewer(ctx context.Context, prID, oldUserID string) (*PullRequestWithReviewers, string, error) {
-	pr, err := s.store.GetPR(ctx, prID)
-	if err != nil {
-		return nil, "", err
-	}
-	if pr == nil {
-		return nil, "", ErrNotFound
-	}
-
-	if pr.Status == store.PRStatusMerged {
-		return nil, "", ErrPRMerged
-	}
-
-	currentReviewers, err := s.store.GetPRReviewers(ctx, prID)
-	if err != nil {
-		return nil, "", err
-	}
-
-	isAssigned := false
-	for _, reviewer := range currentReviewers {
-		if reviewer.UserID == oldUserID {
-			isAssigned = true
-			break
-		}
-	}
-	if !isAssigned {
-		return nil, "", ErrNotAssigned
-	}
-
-	oldReviewer, err := s.store.GetUser(ctx, oldUserID)
-	if err != nil {
-		return nil, "", err
-	}
-	if oldReviewer == nil {
-		return nil, "", ErrNotFound
-	}
-
-	activeMembers, err := s.store.GetActiveTeamMembers(ctx, oldReviewer.TeamName, &pr.AuthorID)
-	if err != nil {
-		return nil, "", err
-	}
-
-	var availableMembers []store.User
-	currentReviewerMap := make(map[string]bool)
-	for _, reviewer := range currentReviewers {
-		currentReviewerMap[reviewer.UserID] = true
-	}
-
-	for _, member := range activeMembers {
-		if !currentReviewerMap[member.UserID] && member.UserID != oldUserID {
-			availableMembers = append(availableMembers, member)
-		}
-	}
-
-	if len(availableMembers) == 0 {
-		return nil, "", ErrNoCandidate
-	}
-
-	newReviewer := availableMembers[rand.Intn(len(availableMembers))]
-
-	if err := s.store.RemoveReviewer(ctx, prID, oldUserID); err != nil {
-		return nil, "", err
-	}
-	if err := s.store.AssignReviewer(ctx, prID, newReviewer.UserID); err != nil {
-		return nil, "", err
-	}
-
-	updatedReviewers, err := s.store.GetPRReviewers(ctx, prID)
-	if err != nil {
-		return nil, "", err
-	}
-
-	result := &PullRequestWithReviewers{
-		PullRequest:       pr,
-		AssignedReviewers: updatedReviewers,
-	}
-
-	return result, newReviewer.UserID, nil
-}
-
-func (s *Service) GetUserAssignedPRs(ctx context.Context, userID string) ([]*PullRequestWithReviewers, error) {
-	prs, err := s.store.GetUserAssignedPRs(ctx, userID)
-	if err != nil {
-		return nil, err
-	}
-
-	var result []*PullRequestWithReviewers
-	for _, pr := range prs {
-		reviewers, err := s.store.GetPRReviewers(ctx, pr.PullRequestID)
-		if err != nil {
-			return nil, err
-		}
-		result = append(result, &PullRequestWithReviewers{
-			PullRequest:       &pr,
-			AssignedReviewers: reviewers,
-		})
-	}
-
-	return result, nil
-}
-
-func (s *Service) GetPR(ctx context.Context, prID string) (*PullRequestWithReviewers, error) {
-	pr, err := s.store.GetPR(ctx, prID)
-	if err != nil {
-		return nil, err
-	}
-	if pr == nil {
-		return nil, ErrNotFound
-	}
-
-	reviewers, err := s.store.GetPRReviewers(ctx, prID)
-	if err != nil {
-		return nil, err
-	}
-
-	return &PullRequestWithReviewers{
-		PullRequest:       pr,
-		AssignedReviewers: reviewers,
-	}, nil
-}
-
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+var (
+	ErrPRExists         = errors.New("PR id already exists")
+	ErrTeamExists       = errors.New("team_name already exists")
+	ErrPRMerged         = errors.New("cannot reassign on merged PR")
+	ErrPRClosed         = errors.New("cannot reassign on closed PR")
+	ErrNotAssigned      = errors.New("reviewer is not assigned to this PR")
+	ErrNoCandidate      = errors.New("no active replacement candidate in team")
+	ErrInvalidCandidate = errors.New("new_user_id must be an active team member who is not the author or already assigned")
+	ErrReassignConflict = errors.New("replacement reviewer was assigned concurrently, retry the request")
+	ErrNotFound         = errors.New("resource not found")
+
+	ErrPRMergedApprove       = errors.New("cannot approve merged PR")
+	ErrPRClosedApprove       = errors.New("cannot approve closed PR")
+	ErrInsufficientApprovals = errors.New("PR needs at least one approval from an assigned reviewer")
+
+	ErrInvalidBucket = errors.New("bucket must be one of: day, week")
+	ErrInvalidRange  = errors.New("since must be within the last year and not in the future")
+	ErrInvalidExpand = errors.New("expand must be one of: load")
+	ErrEmptyPRName   = errors.New("pull_request_name must not be empty")
+	ErrPRMergedEdit  = errors.New("cannot edit merged PR")
+	ErrPRMergedClose = errors.New("cannot close merged PR")
+	ErrPRClosedMerge = errors.New("cannot merge closed PR")
+	ErrInvalidFactor = errors.New("factor must be greater than 1")
+	ErrInvalidLimit  = errors.New("limit must be between 1 and 100")
+	ErrInvalidOffset = errors.New("offset must not be negative")
+	ErrInvalidWindow = errors.New("until must be after since")
+	ErrInvalidExpiry = errors.New("expires_at must be in the future")
+
+	ErrInvalidMemberRange = errors.New("min_members must be non-negative and not greater than max_members")
+	ErrInvalidRecurrence  = errors.New("recurrence must be one of: none, weekly")
+	ErrInvalidBlackout    = errors.New("ends_at must be after starts_at and weekly windows must be shorter than a week")
+	ErrBlackoutOverlap    = errors.New("blackout window overlaps an existing one")
+	ErrInvalidPattern     = errors.New("path pattern must be a non-empty glob")
+	ErrOwnerNotInTeam     = errors.New("path owners must be members of the team")
+	ErrInvalidFormat      = errors.New("format must be one of: csv, jsonl")
+	ErrInvalidQuota       = errors.New("weekly quota must not be negative")
+	ErrInvalidDeviation   = errors.New("max_deviation must be at least 1")
+	ErrInvalidPRExpand    = errors.New("expand must be one of: reviewers")
+	ErrSnapshotVersion    = errors.New("both snapshots must use a supported schema_version")
+	ErrTeamRequired       = errors.New("team_name must not be empty")
+	ErrInvalidThreshold   = errors.New("min_reassigns must be at least 1")
+	ErrInvalidStrategy    = errors.New("strategy must be one of: RANDOM, WEIGHTED, LEAST_LOADED")
+	ErrInvalidRequired    = errors.New("required_reviewers must be at least 1")
+	ErrInvalidSkill       = errors.New("skills must be non-empty strings")
+	ErrInvalidMember      = errors.New("user_id must not be empty and username is required for new members")
+	ErrMemberOtherTeam    = errors.New("user belongs to another team")
+	ErrInvalidPriority    = errors.New("priority must be one of: NORMAL, HIGH")
+	ErrInvalidWebhook     = errors.New("url must be an absolute http(s) URL, secret must not be empty and events must be FROM->TO transitions")
+	ErrInvalidStatus      = errors.New("status must be one of: OPEN, MERGED, CLOSED")
+	ErrInvalidFallback    = errors.New("fallback_teams must name existing teams other than the team itself")
+
+	ErrInvalidAPIKey   = errors.New("invalid API key")
+	ErrUnauthenticated = errors.New("a valid X-API-Key is required")
+	ErrForbidden       = errors.New("cannot act on behalf of another user")
+)
+
+var prStatuses = []store.PullRequestStatus{store.PRStatusOpen, store.PRStatusMerged, store.PRStatusClosed}
+
+const (
+	defaultRequiredReviewers = 2
+	defaultUserReviewsLimit  = 100
+
+	ExpandReviewers = "reviewers"
+)
+
+type MemberValidationError struct {
+	Index  int
+	UserID string
+	Reason string
+}
+
+func (e *MemberValidationError) Error() string {
+	if e.UserID == "" {
+		return fmt.Sprintf("members[%d]: %s", e.Index, e.Reason)
+	}
+	return fmt.Sprintf("members[%d] (%s): %s", e.Index, e.UserID, e.Reason)
+}
+
+type TeamMember struct {
+	UserID   string
+	Username string
+	IsActive bool
+}
+
+type CreatePROptions struct {
+	ReviewDeadline       *time.Time
+	RelatedPullRequestID *string
+	Paths                []string
+	RequiredSkills       []string
+	Priority             string
+}
+
+type PullRequestWithReviewers struct {
+	PullRequest       *store.PullRequest
+	AssignedReviewers []store.User
+	Approvals         []store.Approval
+	RequiredReviewers int
+	RelatedFallback   bool
+	Suppressed        bool
+	QuotaExceeded     bool
+	SkillFallback     bool
+	FastResponders    bool
+	AssignmentPending bool
+	LoadAtAssignment  map[string]int
+}
+
+type Service struct {
+	store         store.Store
+	hooks         []AssignmentHook
+	flags         *FeatureFlags
+	strategy      string
+	defaultTeam   string
+	createLimiter *RateLimiter
+	retry         AssignmentRetryConfig
+	webhooks      *WebhookDispatcher
+	metrics       *Metrics
+
+	assignmentWebhook    WebhookDelivery
+	recentReviewerWindow int
+}
+
+func NewService(store store.Store, opts ...Option) *Service {
+	rand.Seed(time.Now().UnixNano())
+	s := &Service{store: store, strategy: StrategyLeastLoaded, recentReviewerWindow: defaultRecentReviewerWindow}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *Service) CreateOrUpdateTeam(ctx context.Context, teamName string, members []TeamMember, requiredReviewers *int, fallbackTeams *[]string) (*store.Team, bool, error) {
+	if requiredReviewers != nil && *requiredReviewers < 1 {
+		return nil, false, ErrInvalidRequired
+	}
+	if err := validateTeamMembers(members); err != nil {
+		return nil, false, err
+	}
+
+	var fallbacks []string
+	if fallbackTeams != nil {
+		var err error
+		fallbacks, err = s.validateFallbackTeams(ctx, teamName, *fallbackTeams)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	existingTeam, err := s.store.GetTeam(ctx, teamName)
+	if err != nil {
+		return nil, false, err
+	}
+
+	users := make([]store.User, len(members))
+	for i, member := range members {
+		users[i] = store.User{
+			UserID:   member.UserID,
+			Username: member.Username,
+			IsActive: member.IsActive,
+			TeamName: teamName,
+		}
+	}
+
+	if existingTeam != nil {
+		if requiredReviewers != nil {
+			existingTeam.RequiredReviewers = *requiredReviewers
+		}
+		if err := s.store.UpdateTeamWithMembers(ctx, existingTeam, users); err != nil {
+			return nil, false, err
+		}
+		if fallbackTeams != nil {
+			if err := s.store.ReplaceFallbackTeams(ctx, teamName, fallbacks); err != nil {
+				return nil, false, err
+			}
+		}
+		return existingTeam, false, nil
+	}
+
+	team := &store.Team{Name: teamName, RequiredReviewers: defaultRequiredReviewers}
+	if requiredReviewers != nil {
+		team.RequiredReviewers = *requiredReviewers
+	}
+	if err := s.store.CreateTeamWithMembers(ctx, team, users); err != nil {
+		if errors.Is(err, store.ErrDuplicateKey) {
+			return nil, false, ErrTeamExists
+		}
+		return nil, false, err
+	}
+	if fallbackTeams != nil {
+		if err := s.store.ReplaceFallbackTeams(ctx, teamName, fallbacks); err != nil {
+			return nil, false, err
+		}
+	}
+
+	return team, true, nil
+}
+
+func (s *Service) teamRequiredReviewers(ctx context.Context, teamName string) (int, error) {
+	team, err := s.store.GetTeam(ctx, teamName)
+	if err != nil {
+		return 0, err
+	}
+	if team == nil || team.RequiredReviewers < 1 {
+		return defaultRequiredReviewers, nil
+	}
+	return team.RequiredReviewers, nil
+}
+
+func validateTeamMembers(members []TeamMember) error {
+	seen := make(map[string]bool, len(members))
+	for i, member := range members {
+		switch {
+		case strings.TrimSpace(member.UserID) == "":
+			return &MemberValidationError{Index: i, Reason: "user_id must not be empty"}
+		case strings.TrimSpace(member.Username) == "":
+			return &MemberValidationError{Index: i, UserID: member.UserID, Reason: "username must not be empty"}
+		case seen[member.UserID]:
+			return &MemberValidationError{Index: i, UserID: member.UserID, Reason: "duplicate user_id"}
+		}
+		seen[member.UserID] = true
+	}
+	return nil
+}
+
+func (s *Service) GetTeam(ctx context.Context, teamName string) (*store.Team, []store.User, error) {
+	team, err := s.store.GetTeam(ctx, teamName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if team == nil {
+		return nil, nil, ErrNotFound
+	}
+
+	members, err := s.store.GetTeamMembers(ctx, teamName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return team, members, nil
+}
+
+func (s *Service) SetUserActive(ctx context.Context, userID string, isActive, reassignOpenReviews bool) (*store.User, *OpenReviewHandoff, error) {
+	user, err := s.store.GetUser(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user == nil {
+		return nil, nil, ErrNotFound
+	}
+
+	user.IsActive = isActive
+	if err := s.store.UpdateUser(ctx, user); err != nil {
+		return nil, nil, err
+	}
+
+	if isActive || !reassignOpenReviews {
+		return user, nil, nil
+	}
+	handoff, err := s.reassignOpenReviews(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return user, handoff, nil
+}
+
+func (s *Service) BoostUser(ctx context.Context, userID string, factor float64, expiresAt time.Time) (*store.User, error) {
+	if factor <= 1 {
+		return nil, ErrInvalidFactor
+	}
+	if !expiresAt.After(time.Now()) {
+		return nil, ErrInvalidExpiry
+	}
+
+	user, err := s.store.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrNotFound
+	}
+
+	if err := s.store.SetUserBoost(ctx, userID, factor, expiresAt); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (s *Service) CreatePR(ctx context.Context, prID, prName, authorID string, opts CreatePROptions) (*PullRequestWithReviewers, error) {
+	if err := s.allowCreate(authorID); err != nil {
+		return nil, err
+	}
+
+	requiredSkills, err := normalizeSkills(opts.RequiredSkills)
+	if err != nil {
+		return nil, err
+	}
+	opts.RequiredSkills = requiredSkills
+
+	opts.Priority, err = normalizePriority(opts.Priority)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := s.store.GetUser(ctx, authorID)
+	if err != nil {
+		return nil, err
+	}
+	if author == nil {
+		return nil, ErrNotFound
+	}
+
+	existingPR, err := s.store.GetPR(ctx, store.PRKey{TeamName: author.TeamName, PullRequestID: prID})
+	if err != nil {
+		return nil, err
+	}
+	if existingPR != nil {
+		return nil, ErrPRExists
+	}
+
+	activeMembers, err := s.store.GetActiveTeamMembers(ctx, author.TeamName, &authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	required, err := s.teamRequiredReviewers(ctx, author.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	ac := AssignmentContext{
+		PullRequestID: prID,
+		AuthorID:      authorID,
+		TeamName:      author.TeamName,
+	}
+
+	suppressed, err := s.assignmentSuppressed(ctx, author.TeamName, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	var reviewers []store.User
+	var reasons map[string]store.AssignmentReason
+	quotaExceeded := false
+	skillFallback := false
+	if !suppressed {
+		var candidates []store.User
+		candidates, quotaExceeded, err = s.withinQuota(ctx, activeMembers, time.Now().UTC())
+		if err != nil {
+			return nil, err
+		}
+		candidates, skillFallback, err = s.withSkills(ctx, candidates, opts.RequiredSkills)
+		if err != nil {
+			return nil, err
+		}
+		reviewers, reasons, err = s.assignReviewers(ctx, ac, candidates, opts, required)
+		if err != nil {
+			return nil, err
+		}
+		reviewers, err = s.fillFromFallbackTeams(ctx, ac, reviewers, reasons, required)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pr := &store.PullRequest{
+		PullRequestID:   prID,
+		PullRequestName: prName,
+		AuthorID:        authorID,
+		Status:          store.PRStatusOpen,
+		CreatedAt:       time.Now(),
+		ReviewDeadline:  opts.ReviewDeadline,
+		TeamName:        author.TeamName,
+	}
+
+	relatedFallback := false
+	fastResponders := false
+	assignments := make([]store.ReviewerAssignment, len(reviewers))
+	for i, reviewer := range reviewers {
+		reason := reasons[reviewer.UserID]
+		if reason.Reason == ReasonRelatedFallback {
+			relatedFallback = true
+		}
+		if reason.Strategy == StrategyFastResponse {
+			fastResponders = true
+		}
+		assignments[i] = store.ReviewerAssignment{UserID: reviewer.UserID, Reason: reason}
+	}
+
+	loads, err := s.store.CreatePRWithReviewers(ctx, pr, assignments)
+	if errors.Is(err, store.ErrDuplicateKey) {
+		return nil, ErrPRExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.metrics.prCreated(pr.TeamName)
+	s.notifyAssignment(pr, reviewers, "")
+
+	pending := false
+	if !suppressed && len(reviewers) == 0 {
+		pending = s.enqueueAssignmentRetry(ctx, pr.Key(), time.Now())
+	}
+
+	return &PullRequestWithReviewers{
+		PullRequest:       pr,
+		AssignedReviewers: reviewers,
+		RequiredReviewers: required,
+		RelatedFallback:   relatedFallback,
+		Suppressed:        suppressed,
+		QuotaExceeded:     quotaExceeded,
+		SkillFallback:     skillFallback,
+		FastResponders:    fastResponders,
+		AssignmentPending: pending,
+		LoadAtAssignment:  loads,
+	}, nil
+}
+
+func (s *Service) assignReviewers(ctx context.Context, ac AssignmentContext, candidates []store.User, opts CreatePROptions, count int) ([]store.User, map[string]store.AssignmentReason, error) {
+	reasons := make(map[string]store.AssignmentReason)
+
+	var owners []store.User
+	if len(opts.Paths) > 0 {
+		matched, rest, patterns, err := s.splitByOwnership(ctx, ac.TeamName, candidates, opts.Paths)
+		if err != nil {
+			return nil, nil, err
+		}
+		owners, err = s.selectReviewers(ctx, ac, matched, count)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, owner := range owners {
+			reasons[owner.UserID] = s.assignmentReason(ReasonPathOwner, "owns "+strings.Join(patterns[owner.UserID], ", "))
+		}
+		candidates = rest
+		count -= len(owners)
+		if count == 0 {
+			return owners, reasons, nil
+		}
+	}
+
+	var reviewers []store.User
+	var reviewedRelated map[string]bool
+	var fastReasons map[string]store.AssignmentReason
+	var err error
+	if opts.RelatedPullRequestID != nil && *opts.RelatedPullRequestID != "" {
+		reviewers, reviewedRelated, err = s.selectFreshReviewers(ctx, ac, candidates, *opts.RelatedPullRequestID, count)
+	} else if s.fastResponderRouting(ctx, opts) {
+		reviewers, fastReasons, err = s.selectFastResponders(ctx, ac, candidates, count)
+	} else {
+		reviewers, err = s.selectAvoidingRecent(ctx, ac, candidates, count)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, reviewer := range reviewers {
+		if reviewedRelated[reviewer.UserID] {
+			reasons[reviewer.UserID] = s.assignmentReason(ReasonRelatedFallback, "reviewed related PR "+*opts.RelatedPullRequestID)
+		} else if reason, ok := fastReasons[reviewer.UserID]; ok {
+			reasons[reviewer.UserID] = reason
+		} else {
+			reasons[reviewer.UserID] = s.assignmentReason(ReasonPool, "active member of team "+ac.TeamName)
+		}
+	}
+	return append(owners, reviewers...), reasons, nil
+}
+
+func (s *Service) MergePR(ctx context.Context, key store.PRKey) (*PullRequestWithReviewers, error) {
+	pr, err := s.store.GetPR(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if pr == nil {
+		return nil, ErrNotFound
+	}
+
+	if pr.Status == store.PRStatusMerged {
+		return s.prWithApprovals(ctx, pr)
+	}
+	if pr.Status == store.PRStatusClosed {
+		return nil, ErrPRClosedMerge
+	}
+
+	approvals, err := s.store.CountPRApprovals(ctx, pr.Key())
+	if err != nil {
+		return nil, err
+	}
+	if approvals < 1 {
+		return nil, ErrInsufficientApprovals
+	}
+
+	now := time.Now()
+	pr.Status = store.PRStatusMerged
+	pr.MergedAt = &now
+
+	if err := s.store.UpdatePR(ctx, pr); err != nil {
+		return nil, err
+	}
+	s.metrics.prMerged(pr.TeamName)
+	s.notifyStatusChange(ctx, pr, store.PRStatusOpen)
+
+	return s.prWithApprovals(ctx, pr)
+}
+
+func (s *Service) ClosePR(ctx context.Context, key store.PRKey) (*PullRequestWithReviewers, error) {
+	pr, err := s.store.GetPR(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if pr == nil {
+		return nil, ErrNotFound
+	}
+
+	if pr.Status == store.PRStatusMerged {
+		return nil, ErrPRMergedClose
+	}
+	if pr.Status == store.PRStatusOpen {
+		pr.Status = store.PRStatusClosed
+		if err := s.store.UpdatePR(ctx, pr); err != nil {
+			return nil, err
+		}
+		s.notifyStatusChange(ctx, pr, store.PRStatusOpen)
+	}
+
+	reviewers, err := s.store.GetPRReviewers(ctx, pr.Key())
+	if err != nil {
+		return nil, err
+	}
+
+	return &PullRequestWithReviewers{
+		PullRequest:       pr,
+		AssignedReviewers: reviewers,
+	}, nil
+}
+
+func (s *Service) UpdatePRName(ctx context.Context, key store.PRKey, prName string, admin bool) (*PullRequestWithReviewers, error) {
+	prName = strings.TrimSpace(prName)
+	if prName == "" {
+		return nil, ErrEmptyPRName
+	}
+
+	pr, err := s.store.GetPR(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if pr == nil {
+		return nil, ErrNotFound
+	}
+
+	if pr.Status == store.PRStatusMerged && !admin {
+		return nil, ErrPRMergedEdit
+	}
+
+	pr.PullRequestName = prName
+	if err := s.store.UpdatePR(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	reviewers, err := s.store.GetPRReviewers(ctx, pr.Key())
+	if err != nil {
+		return nil, err
+	}
+
+	return &PullRequestWithReviewers{
+		PullRequest:       pr,
+		AssignedReviewers: reviewers,
+	}, nil
+}
+
+func (s *Service) ReassignReviewer(ctx context.Context, key store.PRKey, oldUserID string) (*PullRequestWithReviewers, string, error) {
+	return s.reassignReviewer(ctx, key, oldUserID, nil)
+}
+
+func (s *Service) ReassignReviewerTo(ctx context.Context, key store.PRKey, oldUserID, newUserID string) (*PullRequestWithReviewers, string, error) {
+	return s.reassignReviewer(ctx, key, oldUserID, &newUserID)
+}
+
+func (s *Service) reassignReviewer(ctx context.Context, key store.PRKey, oldUserID string, newUserID *string) (*PullRequestWithReviewers, string, error) {
+	pr, err := s.store.GetPR(ctx, key)
+	if err != nil {
+		return nil, "", err
+	}
+	if pr == nil {
+		return nil, "", ErrNotFound
+	}
+
+	if pr.Status == store.PRStatusMerged {
+		return nil, "", ErrPRMerged
+	}
+	if pr.Status == store.PRStatusClosed {
+		return nil, "", ErrPRClosed
+	}
+
+	currentReviewers, err := s.store.GetPRReviewers(ctx, pr.Key())
+	if err != nil {
+		return nil, "", err
+	}
+
+	isAssigned := false
+	for _, reviewer := range currentReviewers {
+		if reviewer.UserID == oldUserID {
+			isAssigned = true
+			break
+		}
+	}
+	if !isAssigned {
+		return nil, "", ErrNotAssigned
+	}
+
+	oldReviewer, err := s.store.GetUser(ctx, oldUserID)
+	if err != nil {
+		return nil, "", err
+	}
+	if oldReviewer == nil {
+		return nil, "", ErrNotFound
+	}
+
+	availableMembers, err := s.replacementCandidates(ctx, pr, oldReviewer, currentReviewers)
+	if err != nil {
+		return nil, "", err
+	}
+
+	reason := s.assignmentReason(ReasonReassignment, "replaced "+oldUserID)
+	if len(availableMembers) == 0 {
+		exclude := map[string]bool{pr.AuthorID: true, oldUserID: true}
+		for _, reviewer := range currentReviewers {
+			exclude[reviewer.UserID] = true
+		}
+		availableMembers, err = s.fallbackCandidates(ctx, oldReviewer.TeamName, exclude)
+		if err != nil {
+			return nil, "", err
+		}
+		reason.Reason = ReasonCrossTeamFallback
+	}
+
+	var newReviewer store.User
+	if newUserID != nil {
+		candidate, ok := findUser(availableMembers, *newUserID)
+		if !ok {
+			return nil, "", ErrInvalidCandidate
+		}
+		newReviewer = candidate
+		reason.Detail += " by request"
+	} else {
+		selected, err := s.selectReviewers(ctx, AssignmentContext{
+			PullRequestID: pr.PullRequestID,
+			AuthorID:      pr.AuthorID,
+			TeamName:      oldReviewer.TeamName,
+			ReplacedUser:  oldUserID,
+		}, availableMembers, 1)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(selected) == 0 {
+			s.metrics.reassignNoCandidate(oldReviewer.TeamName)
+			return nil, "", ErrNoCandidate
+		}
+		newReviewer = selected[0]
+	}
+
+	replaced, err := s.store.ReplaceReviewer(ctx, pr.Key(), oldUserID, newReviewer.UserID, reason)
+	if err != nil {
+		return nil, "", err
+	}
+	if !replaced {
+		return nil, "", ErrReassignConflict
+	}
+	s.metrics.reassigned(oldReviewer.TeamName)
+	s.notifyAssignment(pr, []store.User{newReviewer}, oldUserID)
+
+	updatedReviewers, err := s.store.GetPRReviewers(ctx, pr.Key())
+	if err != nil {
+		return nil, "", err
+	}
+
+	result := &PullRequestWithReviewers{
+		PullRequest:       pr,
+		AssignedReviewers: updatedReviewers,
+	}
+
+	return result, newReviewer.UserID, nil
+}
+
+func (s *Service) replacementCandidates(ctx context.Context, pr *store.PullRequest, oldReviewer *store.User, currentReviewers []store.User) ([]store.User, error) {
+	activeMembers, err := s.store.GetActiveTeamMembers(ctx, oldReviewer.TeamName, &pr.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+
+	var availableMembers []store.User
+	currentReviewerMap := make(map[string]bool)
+	for _, reviewer := range currentReviewers {
+		currentReviewerMap[reviewer.UserID] = true
+	}
+
+	for _, member := range activeMembers {
+		if !currentReviewerMap[member.UserID] && member.UserID != oldReviewer.UserID {
+			availableMembers = append(availableMembers, member)
+		}
+	}
+	return availableMembers, nil
+}
+
+func findUser(users []store.User, userID string) (store.User, bool) {
+	for _, user := range users {
+		if user.UserID == userID {
+			return user, true
+		}
+	}
+	return store.User{}, false
+}
+
+func (s *Service) GetPRHistory(ctx context.Context, key store.PRKey) ([]store.ReassignmentEvent, error) {
+	pr, err := s.store.GetPR(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if pr == nil {
+		return nil, ErrNotFound
+	}
+
+	return s.store.GetReassignmentEvents(ctx, pr.Key())
+}
+
+func (s *Service) GetUserAssignedPRs(ctx context.Context, userID string, status *string, limit, offset *int) ([]*PullRequestWithReviewers, int, error) {
+	filter, err := resolveStatus(status)
+	if err != nil {
+		return nil, 0, err
+	}
+	n, err := resolveLimit(limit, defaultUserReviewsLimit)
+	if err != nil {
+		return nil, 0, err
+	}
+	skip, err := resolveOffset(offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	prs, total, err := s.store.GetUserAssignedPRs(ctx, userID, filter, n, skip)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result, err := s.withReviewers(ctx, prs)
+	return result, total, err
+}
+
+func resolveStatus(status *string) (*store.PullRequestStatus, error) {
+	if status == nil {
+		return nil, nil
+	}
+	for _, known := range prStatuses {
+		if *status == string(known) {
+			return &known, nil
+		}
+	}
+	return nil, ErrInvalidStatus
+}
+
+func (s *Service) withReviewers(ctx context.Context, prs []store.PullRequest) ([]*PullRequestWithReviewers, error) {
+	var result []*PullRequestWithReviewers
+	for _, pr := range prs {
+		reviewers, err := s.store.GetPRReviewers(ctx, pr.Key())
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &PullRequestWithReviewers{
+			PullRequest:       &pr,
+			AssignedReviewers: reviewers,
+		})
+	}
+
+	return result, nil
+}
+
+func (s *Service) GetPR(ctx context.Context, key store.PRKey) (*PullRequestWithReviewers, error) {
+	pr, err := s.store.GetPR(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if pr == nil {
+		return nil, ErrNotFound
+	}
+
+	return s.prWithApprovals(ctx, pr)
+}
+
+func pickRandom(users []store.User, count int) []store.User {
+	if len(users) == 0 {
+		return nil
+	}
+	shuffled := make([]store.User, len(users))
+	copy(shuffled, users)
+	rand.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return shuffled[:min(count, len(shuffled))]
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+func newTestService(t *testing.T, members []TeamMember) *Service {
+	t.Helper()
+
+	s := NewService(store.NewMemoryStore())
+	if _, _, err := s.CreateOrUpdateTeam(context.Background(), "backend", members, nil, nil); err != nil {
+		t.Fatalf("create team: %v", err)
+	}
+	return s
+}
+
+func reviewerIDs(users []store.User) []string {
+	ids := make([]string, len(users))
+	for i, user := range users {
+		ids[i] = user.UserID
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func TestCreatePR(t *testing.T) {
+	tests := []struct {
+		name    string
+		members []TeamMember
+		author  string
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "author excluded",
+			members: []TeamMember{
+				{UserID: "u1", Username: "Alice", IsActive: true},
+				{UserID: "u2", Username: "Bob", IsActive: true},
+				{UserID: "u3", Username: "Carol", IsActive: true},
+			},
+			author: "u1",
+			want:   []string{"u2", "u3"},
+		},
+		{
+			name: "inactive users excluded",
+			members: []TeamMember{
+				{UserID: "u1", Username: "Alice", IsActive: true},
+				{UserID: "u2", Username: "Bob", IsActive: false},
+				{UserID: "u3", Username: "Carol", IsActive: true},
+				{UserID: "u4", Username: "Dave", IsActive: false},
+			},
+			author: "u1",
+			want:   []string{"u3"},
+		},
+		{
+			name: "fewer than two candidates",
+			members: []TeamMember{
+				{UserID: "u1", Username: "Alice", IsActive: true},
+				{UserID: "u2", Username: "Bob", IsActive: true},
+			},
+			author: "u1",
+			want:   []string{"u2"},
+		},
+		{
+			name: "no candidates",
+			members: []TeamMember{
+				{UserID: "u1", Username: "Alice", IsActive: true},
+			},
+			author: "u1",
+			want:   []string{},
+		},
+		{
+			name: "unknown author",
+			members: []TeamMember{
+				{UserID: "u1", Username: "Alice", IsActive: true},
+			},
+			author:  "u9",
+			wantErr: ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestService(t, tt.members)
+
+			result, err := s.CreatePR(context.Background(), "pr-1", "Add search", tt.author, CreatePROptions{})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreatePR() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			got := reviewerIDs(result.AssignedReviewers)
+			if len(got) != len(tt.want) {
+				t.Fatalf("CreatePR() reviewers = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("CreatePR() reviewers = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestCreatePRSameIDInTwoTeams(t *testing.T) {
+	tests := []struct {
+		name       string
+		teamScoped bool
+		wantErr    error
+	}{
+		{name: "global ids", wantErr: ErrPRExists},
+		{name: "team scoped ids", teamScoped: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			st := store.NewMemoryStore()
+			if err := st.SetTeamScopedPRIDs(ctx, tt.teamScoped); err != nil {
+				t.Fatalf("SetTeamScopedPRIDs() error = %v", err)
+			}
+			s := NewService(st)
+			for _, team := range []string{"backend", "frontend"} {
+				members := []TeamMember{
+					{UserID: team + "-1", Username: "Alice", IsActive: true},
+					{UserID: team + "-2", Username: "Bob", IsActive: true},
+				}
+				if _, _, err := s.CreateOrUpdateTeam(ctx, team, members, nil, nil); err != nil {
+					t.Fatalf("create team %s: %v", team, err)
+				}
+			}
+
+			if _, err := s.CreatePR(ctx, "pr-1", "Add search", "backend-1", CreatePROptions{}); err != nil {
+				t.Fatalf("CreatePR() in backend error = %v", err)
+			}
+			_, err := s.CreatePR(ctx, "pr-1", "Add search", "frontend-1", CreatePROptions{})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreatePR() in frontend error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if _, err := s.GetPR(ctx, store.PRKey{PullRequestID: "pr-1"}); !errors.Is(err, store.ErrAmbiguousPR) {
+				t.Fatalf("GetPR() without team error = %v, want %v", err, store.ErrAmbiguousPR)
+			}
+			if _, err := s.ApprovePR(ctx, store.PRKey{TeamName: "frontend", PullRequestID: "pr-1"}, "frontend-2"); err != nil {
+				t.Fatalf("ApprovePR() error = %v", err)
+			}
+			for _, team := range []string{"backend", "frontend"} {
+				pr, err := s.GetPR(ctx, store.PRKey{TeamName: team, PullRequestID: "pr-1"})
+				if err != nil {
+					t.Fatalf("GetPR(%s) error = %v", team, err)
+				}
+				if got := pr.PullRequest.AuthorID; got != team+"-1" {
+					t.Fatalf("GetPR(%s) author = %q, want %q", team, got, team+"-1")
+				}
+				if got := reviewerIDs(pr.AssignedReviewers); len(got) != 1 || got[0] != team+"-2" {
+					t.Fatalf("GetPR(%s) reviewers = %v, want [%s-2]", team, got, team)
+				}
+			}
+			if _, err := s.MergePR(ctx, store.PRKey{TeamName: "backend", PullRequestID: "pr-1"}); !errors.Is(err, ErrInsufficientApprovals) {
+				t.Fatalf("MergePR(backend) error = %v, want %v", err, ErrInsufficientApprovals)
+			}
+			if _, err := s.MergePR(ctx, store.PRKey{TeamName: "frontend", PullRequestID: "pr-1"}); err != nil {
+				t.Fatalf("MergePR(frontend) error = %v", err)
+			}
+		})
+	}
+}
+
+func TestReassignReviewer(t *testing.T) {
+	tests := []struct {
+		name    string
+		members []TeamMember
+		merge   bool
+		old     func(assigned []string) string
+		wantErr error
+	}{
+		{
+			name: "replaces assigned reviewer",
+			members: []TeamMember{
+				{UserID: "u1", Username: "Alice", IsActive: true},
+				{UserID: "u2", Username: "Bob", IsActive: true},
+				{UserID: "u3", Username: "Carol", IsActive: true},
+				{UserID: "u4", Username: "Dave", IsActive: true},
+			},
+			old: func(assigned []string) string { return assigned[0] },
+		},
+		{
+			name: "merged PR",
+			members: []TeamMember{
+				{UserID: "u1", Username: "Alice", IsActive: true},
+				{UserID: "u2", Username: "Bob", IsActive: true},
+				{UserID: "u3", Username: "Carol", IsActive: true},
+				{UserID: "u4", Username: "Dave", IsActive: true},
+			},
+			merge:   true,
+			old:     func(assigned []string) string { return assigned[0] },
+			wantErr: ErrPRMerged,
+		},
+		{
+			name: "reviewer not assigned",
+			members: []TeamMember{
+				{UserID: "u1", Username: "Alice", IsActive: true},
+				{UserID: "u2", Username: "Bob", IsActive: true},
+				{UserID: "u3", Username: "Carol", IsActive: true},
+			},
+			old:     func(assigned []string) string { return "u1" },
+			wantErr: ErrNotAssigned,
+		},
+		{
+			name: "no replacement candidate",
+			members: []TeamMember{
+				{UserID: "u1", Username: "Alice", IsActive: true},
+				{UserID: "u2", Username: "Bob", IsActive: true},
+				{UserID: "u3", Username: "Carol", IsActive: true},
+			},
+			old:     func(assigned []string) string { return assigned[0] },
+			wantErr: ErrNoCandidate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestService(t, tt.members)
+
+			created, err := s.CreatePR(ctx, "pr-1", "Add search", "u1", CreatePROptions{})
+			if err != nil {
+				t.Fatalf("create PR: %v", err)
+			}
+			assigned := reviewerIDs(created.AssignedReviewers)
+			if tt.merge {
+				if _, err := s.ApprovePR(ctx, store.PRKey{PullRequestID: "pr-1"}, assigned[0]); err != nil {
+					t.Fatalf("approve PR: %v", err)
+				}
+				if _, err := s.MergePR(ctx, store.PRKey{PullRequestID: "pr-1"}); err != nil {
+					t.Fatalf("merge PR: %v", err)
+				}
+			}
+
+			old := tt.old(assigned)
+			result, replacedBy, err := s.ReassignReviewer(ctx, store.PRKey{PullRequestID: "pr-1"}, old)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReassignReviewer() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if replacedBy == old || replacedBy == "u1" {
+				t.Fatalf("ReassignReviewer() replaced %s with %s", old, replacedBy)
+			}
+			for _, id := range reviewerIDs(result.AssignedReviewers) {
+				if id == old {
+					t.Fatalf("ReassignReviewer() kept %s assigned", old)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateOrUpdateTeamInvalidMember(t *testing.T) {
+	tests := []struct {
+		name    string
+		members []TeamMember
+	}{
+		{
+			name: "empty user id",
+			members: []TeamMember{
+				{UserID: "u1", Username: "Alice", IsActive: true},
+				{UserID: " ", Username: "Bob", IsActive: true},
+			},
+		},
+		{
+			name: "empty username",
+			members: []TeamMember{
+				{UserID: "u1", Username: "Alice", IsActive: true},
+				{UserID: "u2", Username: "", IsActive: true},
+			},
+		},
+		{
+			name: "duplicate user id",
+			members: []TeamMember{
+				{UserID: "u1", Username: "Alice", IsActive: true},
+				{UserID: "u1", Username: "Bob", IsActive: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			st := store.NewMemoryStore()
+			s := NewService(st)
+
+			_, _, err := s.CreateOrUpdateTeam(ctx, "backend", tt.members, nil, nil)
+			var validationErr *MemberValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("CreateOrUpdateTeam() error = %v, want MemberValidationError", err)
+			}
+
+			team, err := st.GetTeam(ctx, "backend")
+			if err != nil {
+				t.Fatalf("get team: %v", err)
+			}
+			if team != nil {
+				t.Fatalf("team %q was created", team.Name)
+			}
+			for _, member := range tt.members {
+				user, err := st.GetUser(ctx, member.UserID)
+				if err != nil {
+					t.Fatalf("get user: %v", err)
+				}
+				if user != nil {
+					t.Fatalf("member %q was created", user.UserID)
+				}
+			}
+		})
+	}
+}
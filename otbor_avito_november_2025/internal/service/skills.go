@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+func (s *Service) SetUserSkills(ctx context.Context, userID string, skills []string) (*store.User, []string, error) {
+	normalized, err := normalizeSkills(skills)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user, err := s.store.GetUser(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user == nil {
+		return nil, nil, ErrNotFound
+	}
+
+	if err := s.store.ReplaceUserSkills(ctx, userID, normalized); err != nil {
+		return nil, nil, err
+	}
+	return user, normalized, nil
+}
+
+func (s *Service) withSkills(ctx context.Context, candidates []store.User, required []string) ([]store.User, bool, error) {
+	if len(required) == 0 || len(candidates) == 0 {
+		return candidates, false, nil
+	}
+
+	ids := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		ids[i] = candidate.UserID
+	}
+
+	skilled, err := s.store.GetUsersWithSkills(ctx, ids, required)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var matched []store.User
+	for _, candidate := range candidates {
+		if skilled[candidate.UserID] {
+			matched = append(matched, candidate)
+		}
+	}
+
+	if len(matched) == 0 {
+		return candidates, true, nil
+	}
+	return matched, false, nil
+}
+
+func normalizeSkills(skills []string) ([]string, error) {
+	seen := make(map[string]bool, len(skills))
+	normalized := make([]string, 0, len(skills))
+	for _, skill := range skills {
+		skill = strings.ToLower(strings.TrimSpace(skill))
+		if skill == "" {
+			return nil, ErrInvalidSkill
+		}
+		if seen[skill] {
+			continue
+		}
+		seen[skill] = true
+		normalized = append(normalized, skill)
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
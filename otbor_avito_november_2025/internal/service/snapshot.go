@@ -0,0 +1,109 @@
+package service
+
+import "sort"
+
+const SnapshotSchemaVersion = 1
+
+type TeamSnapshot struct {
+	SchemaVersion int
+	TeamName      string
+	Members       []TeamMember
+	Assignments   []SnapshotAssignment
+}
+
+type SnapshotAssignment struct {
+	PullRequestID string
+	UserID        string
+}
+
+type MemberChange struct {
+	Before TeamMember
+	After  TeamMember
+}
+
+type SnapshotDiff struct {
+	AddedMembers       []TeamMember
+	RemovedMembers     []TeamMember
+	ModifiedMembers    []MemberChange
+	AddedAssignments   []SnapshotAssignment
+	RemovedAssignments []SnapshotAssignment
+}
+
+func DiffTeamSnapshots(before, after TeamSnapshot) (*SnapshotDiff, error) {
+	if before.SchemaVersion != SnapshotSchemaVersion || after.SchemaVersion != SnapshotSchemaVersion {
+		return nil, ErrSnapshotVersion
+	}
+
+	diff := &SnapshotDiff{}
+
+	beforeMembers := indexMembers(before.Members)
+	afterMembers := indexMembers(after.Members)
+	for userID, member := range afterMembers {
+		previous, ok := beforeMembers[userID]
+		switch {
+		case !ok:
+			diff.AddedMembers = append(diff.AddedMembers, member)
+		case previous != member:
+			diff.ModifiedMembers = append(diff.ModifiedMembers, MemberChange{Before: previous, After: member})
+		}
+	}
+	for userID, member := range beforeMembers {
+		if _, ok := afterMembers[userID]; !ok {
+			diff.RemovedMembers = append(diff.RemovedMembers, member)
+		}
+	}
+
+	beforeAssignments := indexAssignments(before.Assignments)
+	afterAssignments := indexAssignments(after.Assignments)
+	for a := range afterAssignments {
+		if !beforeAssignments[a] {
+			diff.AddedAssignments = append(diff.AddedAssignments, a)
+		}
+	}
+	for a := range beforeAssignments {
+		if !afterAssignments[a] {
+			diff.RemovedAssignments = append(diff.RemovedAssignments, a)
+		}
+	}
+
+	sortMembers(diff.AddedMembers)
+	sortMembers(diff.RemovedMembers)
+	sort.Slice(diff.ModifiedMembers, func(i, j int) bool {
+		return diff.ModifiedMembers[i].After.UserID < diff.ModifiedMembers[j].After.UserID
+	})
+	sortAssignments(diff.AddedAssignments)
+	sortAssignments(diff.RemovedAssignments)
+
+	return diff, nil
+}
+
+func indexMembers(members []TeamMember) map[string]TeamMember {
+	index := make(map[string]TeamMember, len(members))
+	for _, member := range members {
+		index[member.UserID] = member
+	}
+	return index
+}
+
+func indexAssignments(assignments []SnapshotAssignment) map[SnapshotAssignment]bool {
+	index := make(map[SnapshotAssignment]bool, len(assignments))
+	for _, a := range assignments {
+		index[a] = true
+	}
+	return index
+}
+
+func sortMembers(members []TeamMember) {
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].UserID < members[j].UserID
+	})
+}
+
+func sortAssignments(assignments []SnapshotAssignment) {
+	sort.Slice(assignments, func(i, j int) bool {
+		if assignments[i].PullRequestID == assignments[j].PullRequestID {
+			return assignments[i].UserID < assignments[j].UserID
+		}
+		return assignments[i].PullRequestID < assignments[j].PullRequestID
+	})
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+const (
+	PriorityNormal = "NORMAL"
+	PriorityHigh   = "HIGH"
+
+	StrategyFastResponse = "FAST_RESPONSE"
+
+	fastResponderWindow     = 30 * 24 * time.Hour
+	fastResponderMinSamples = 3
+)
+
+func normalizePriority(priority string) (string, error) {
+	switch priority = strings.ToUpper(strings.TrimSpace(priority)); priority {
+	case "":
+		return PriorityNormal, nil
+	case PriorityNormal, PriorityHigh:
+		return priority, nil
+	default:
+		return "", ErrInvalidPriority
+	}
+}
+
+func (s *Service) fastResponderRouting(ctx context.Context, opts CreatePROptions) bool {
+	return opts.Priority == PriorityHigh && s.flags.Enabled(ctx, FlagFastResponderRouting)
+}
+
+func (s *Service) selectFastResponders(ctx context.Context, ac AssignmentContext, candidates []store.User, count int) ([]store.User, map[string]store.AssignmentReason, error) {
+	candidates, err := s.eligibleCandidates(ctx, ac, candidates)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		ids[i] = candidate.UserID
+	}
+	times, err := s.store.GetResponseTimes(ctx, ids, time.Now().UTC().Add(-fastResponderWindow))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var measured []store.User
+	for _, candidate := range candidates {
+		if times[candidate.UserID].Samples >= fastResponderMinSamples {
+			measured = append(measured, candidate)
+		}
+	}
+
+	reasons := make(map[string]store.AssignmentReason)
+	var selected []store.User
+	if len(measured) >= count {
+		sort.SliceStable(measured, func(i, j int) bool {
+			return times[measured[i].UserID].AvgSeconds < times[measured[j].UserID].AvgSeconds
+		})
+		selected = measured[:count]
+		for _, user := range selected {
+			rt := times[user.UserID]
+			avg := time.Duration(rt.AvgSeconds * float64(time.Second)).Round(time.Minute)
+			reasons[user.UserID] = store.AssignmentReason{
+				Reason:   ReasonPool,
+				Strategy: StrategyFastResponse,
+				Detail:   fmt.Sprintf("fast responder: avg response %s over %d reviews", avg, rt.Samples),
+			}
+		}
+	} else {
+		loads, err := s.store.GetOpenReviewCounts(ctx, ac.TeamName)
+		if err != nil {
+			return nil, nil, err
+		}
+		selected = pickLeastLoaded(candidates, loads, count)
+		for _, user := range selected {
+			reasons[user.UserID] = store.AssignmentReason{
+				Reason:   ReasonPool,
+				Strategy: StrategyLeastLoaded,
+				Detail:   fmt.Sprintf("least loaded with %d open reviews, response-time data too sparse", loads[user.UserID]),
+			}
+		}
+	}
+
+	selected, err = s.afterSelect(ctx, ac, selected)
+	if err != nil {
+		return nil, nil, err
+	}
+	return selected, reasons, nil
+}
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+const (
+	BucketDay  = "day"
+	BucketWeek = "week"
+
+	defaultStatsWindow = 30 * 24 * time.Hour
+	maxStatsWindow     = 366 * 24 * time.Hour
+
+	defaultLeaderboardLimit = 20
+
+	ExpandLoad = "load"
+
+	busyReviewThreshold = 5
+)
+
+type MemberLoad struct {
+	OpenReviews int
+	Available   bool
+}
+
+type LeaderboardPage struct {
+	TeamName string
+	Since    time.Time
+	Total    int
+	Entries  []store.LeaderboardEntry
+}
+
+type SLACompliance struct {
+	TeamName  string
+	Since     time.Time
+	Compliant int
+	Total     int
+	Percent   *float64
+}
+
+type CrossTeamReviews struct {
+	TeamName string
+	Since    time.Time
+	Total    int
+	Members  []store.CrossTeamReviewer
+}
+
+type TeamStats struct {
+	TeamName string
+	Members  []store.MemberReviewStats
+}
+
+type AssignmentTrend struct {
+	TeamName string
+	Bucket   string
+	Since    time.Time
+	Points   []store.TrendPoint
+}
+
+func (s *Service) GetAssignmentTrend(ctx context.Context, teamName string, since *time.Time, bucket string) (*AssignmentTrend, error) {
+	if bucket == "" {
+		bucket = BucketDay
+	}
+	if bucket != BucketDay && bucket != BucketWeek {
+		return nil, ErrInvalidBucket
+	}
+
+	now := time.Now().UTC()
+	from, err := resolveSince(since, now)
+	if err != nil {
+		return nil, err
+	}
+	from = truncateToBucket(from, bucket)
+
+	if err := s.requireTeam(ctx, teamName); err != nil {
+		return nil, err
+	}
+
+	points, err := s.store.GetAssignmentTrend(ctx, teamName, bucket, from)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AssignmentTrend{
+		TeamName: teamName,
+		Bucket:   bucket,
+		Since:    from,
+		Points:   fillTrendGaps(points, from, now, bucket),
+	}, nil
+}
+
+func (s *Service) GetLeaderboard(ctx context.Context, teamName string, since *time.Time, limit, offset *int) (*LeaderboardPage, error) {
+	from, err := resolveSince(since, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	n, err := resolveLimit(limit, defaultLeaderboardLimit)
+	if err != nil {
+		return nil, err
+	}
+	skip, err := resolveOffset(offset)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireTeam(ctx, teamName); err != nil {
+		return nil, err
+	}
+
+	entries, total, err := s.store.GetReviewLeaderboard(ctx, teamName, from, n, skip)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LeaderboardPage{
+		TeamName: teamName,
+		Since:    from,
+		Total:    total,
+		Entries:  entries,
+	}, nil
+}
+
+func (s *Service) GetSLACompliance(ctx context.Context, teamName string, since *time.Time) (*SLACompliance, error) {
+	now := time.Now().UTC()
+	from, err := resolveSince(since, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireTeam(ctx, teamName); err != nil {
+		return nil, err
+	}
+
+	compliant, total, err := s.store.GetDeadlineCompliance(ctx, teamName, from, now)
+	if err != nil {
+		return nil, err
+	}
+
+	sla := &SLACompliance{
+		TeamName:  teamName,
+		Since:     from,
+		Compliant: compliant,
+		Total:     total,
+	}
+	if total > 0 {
+		percent := float64(compliant) / float64(total) * 100
+		sla.Percent = &percent
+	}
+	return sla, nil
+}
+
+func (s *Service) GetCrossTeamReviews(ctx context.Context, teamName string, since *time.Time) (*CrossTeamReviews, error) {
+	from, err := resolveSince(since, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireTeam(ctx, teamName); err != nil {
+		return nil, err
+	}
+
+	members, err := s.store.GetCrossTeamReviewCounts(ctx, teamName, from)
+	if err != nil {
+		return nil, err
+	}
+
+	total := 0
+	for _, member := range members {
+		total += member.Reviews
+	}
+	return &CrossTeamReviews{
+		TeamName: teamName,
+		Since:    from,
+		Total:    total,
+		Members:  members,
+	}, nil
+}
+
+func (s *Service) GetTeamStats(ctx context.Context, teamName string) (*TeamStats, error) {
+	if err := s.requireTeam(ctx, teamName); err != nil {
+		return nil, err
+	}
+
+	members, err := s.store.GetTeamReviewStats(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	return &TeamStats{TeamName: teamName, Members: members}, nil
+}
+
+func (s *Service) GetTeamMemberLoad(ctx context.Context, teamName string, members []store.User) (map[string]MemberLoad, error) {
+	counts, err := s.store.GetOpenReviewCounts(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	load := make(map[string]MemberLoad, len(members))
+	for _, member := range members {
+		openReviews := counts[member.UserID]
+		load[member.UserID] = MemberLoad{
+			OpenReviews: openReviews,
+			Available:   member.IsActive && openReviews < busyReviewThreshold,
+		}
+	}
+	return load, nil
+}
+
+func (s *Service) requireTeam(ctx context.Context, teamName string) error {
+	team, err := s.store.GetTeam(ctx, teamName)
+	if err != nil {
+		return err
+	}
+	if team == nil {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func resolveSince(since *time.Time, now time.Time) (time.Time, error) {
+	if since == nil {
+		return now.Add(-defaultStatsWindow), nil
+	}
+	from := since.UTC()
+	if from.After(now) || now.Sub(from) > maxStatsWindow {
+		return time.Time{}, ErrInvalidRange
+	}
+	return from, nil
+}
+
+func fillTrendGaps(points []store.TrendPoint, from, to time.Time, bucket string) []store.TrendPoint {
+	counts := make(map[int64]int, len(points))
+	for _, point := range points {
+		counts[truncateToBucket(point.BucketStart, bucket).Unix()] += point.Assignments
+	}
+
+	var result []store.TrendPoint
+	for start := from; !start.After(to); start = nextBucket(start, bucket) {
+		result = append(result, store.TrendPoint{
+			BucketStart: start,
+			Assignments: counts[start.Unix()],
+		})
+	}
+	return result
+}
+
+func truncateToBucket(t time.Time, bucket string) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if bucket == BucketWeek {
+		offset := (int(day.Weekday()) + 6) % 7
+		day = day.AddDate(0, 0, -offset)
+	}
+	return day
+}
+
+func nextBucket(t time.Time, bucket string) time.Time {
+	if bucket == BucketWeek {
+		return t.AddDate(0, 0, 7)
+	}
+	return t.AddDate(0, 0, 1)
+}
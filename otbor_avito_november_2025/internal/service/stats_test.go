@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+func TestGetSLACompliance(t *testing.T) {
+	tests := []struct {
+		name          string
+		deadline      time.Duration
+		approvals     int
+		merge         bool
+		wantCompliant int
+		wantTotal     int
+	}{
+		{name: "approved before deadline", deadline: time.Hour, approvals: 2, wantCompliant: 1, wantTotal: 1},
+		{name: "approved after deadline", deadline: -time.Hour, approvals: 2, wantCompliant: 0, wantTotal: 1},
+		{name: "partially approved within deadline", deadline: time.Hour, approvals: 1, wantCompliant: 0, wantTotal: 0},
+		{name: "merged with partial approvals", deadline: time.Hour, approvals: 1, merge: true, wantCompliant: 0, wantTotal: 1},
+		{name: "unreviewed and overdue", deadline: -time.Hour, wantCompliant: 0, wantTotal: 1},
+		{name: "unreviewed within deadline", deadline: time.Hour, wantCompliant: 0, wantTotal: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newTestService(t, []TeamMember{
+				{UserID: "u1", Username: "Alice", IsActive: true},
+				{UserID: "u2", Username: "Bob", IsActive: true},
+				{UserID: "u3", Username: "Carol", IsActive: true},
+			})
+
+			deadline := time.Now().Add(tt.deadline)
+			created, err := s.CreatePR(ctx, "pr-1", "Add search", "u1", CreatePROptions{ReviewDeadline: &deadline})
+			if err != nil {
+				t.Fatalf("create PR: %v", err)
+			}
+			for _, id := range reviewerIDs(created.AssignedReviewers)[:tt.approvals] {
+				if _, err := s.ApprovePR(ctx, store.PRKey{PullRequestID: "pr-1"}, id); err != nil {
+					t.Fatalf("approve PR: %v", err)
+				}
+			}
+			if tt.merge {
+				if _, err := s.MergePR(ctx, store.PRKey{PullRequestID: "pr-1"}); err != nil {
+					t.Fatalf("merge PR: %v", err)
+				}
+			}
+
+			since := time.Now().Add(-24 * time.Hour)
+			sla, err := s.GetSLACompliance(ctx, "backend", &since)
+			if err != nil {
+				t.Fatalf("GetSLACompliance() error = %v", err)
+			}
+			if sla.Compliant != tt.wantCompliant || sla.Total != tt.wantTotal {
+				t.Fatalf("GetSLACompliance() = %d/%d, want %d/%d", sla.Compliant, sla.Total, tt.wantCompliant, tt.wantTotal)
+			}
+		})
+	}
+}
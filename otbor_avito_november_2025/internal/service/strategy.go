@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+const (
+	StrategyRandom      = "RANDOM"
+	StrategyWeighted    = "WEIGHTED"
+	StrategyLeastLoaded = "LEAST_LOADED"
+)
+
+type StrategyOutcomes struct {
+	Since      time.Time
+	Strategies []store.StrategyOutcome
+}
+
+func WithAssignmentStrategy(strategy string) Option {
+	return func(s *Service) {
+		s.strategy = strategy
+	}
+}
+
+func (s *Service) GetStrategyOutcomes(ctx context.Context, since *time.Time) (*StrategyOutcomes, error) {
+	from, err := resolveSince(since, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	strategies, err := s.store.GetStrategyOutcomes(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StrategyOutcomes{
+		Since:      from,
+		Strategies: strategies,
+	}, nil
+}
+
+func (s *Service) pick(ctx context.Context, candidates []store.User, count int) ([]store.User, error) {
+	return s.pickWithStrategy(ctx, s.strategy, candidates, count)
+}
+
+func (s *Service) pickWithStrategy(ctx context.Context, strategy string, candidates []store.User, count int) ([]store.User, error) {
+	if len(candidates) == 0 {
+		return pickRandom(candidates, count), nil
+	}
+	switch strategy {
+	case StrategyLeastLoaded:
+		loads, err := s.openPRCounts(ctx, candidates)
+		if err != nil {
+			return nil, err
+		}
+		return pickLeastLoaded(candidates, loads, count), nil
+	case StrategyWeighted:
+		ids := make([]string, len(candidates))
+		for i, candidate := range candidates {
+			ids[i] = candidate.UserID
+		}
+		weights, err := s.store.GetReviewWeights(ctx, ids, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		return pickWeighted(candidates, weights, count), nil
+	default:
+		return pickRandom(candidates, count), nil
+	}
+}
+
+func (s *Service) openPRCounts(ctx context.Context, candidates []store.User) (map[string]int, error) {
+	loads := make(map[string]int, len(candidates))
+	seen := make(map[string]bool)
+	for _, candidate := range candidates {
+		if seen[candidate.TeamName] {
+			continue
+		}
+		seen[candidate.TeamName] = true
+
+		members, err := s.store.GetActiveTeamMembersWithOpenPRCount(ctx, candidate.TeamName)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			loads[member.User.UserID] = member.OpenPRs
+		}
+	}
+	return loads, nil
+}
+
+func pickLeastLoaded(users []store.User, loads map[string]int, count int) []store.User {
+	pool := make([]store.User, len(users))
+	copy(pool, users)
+	rand.Shuffle(len(pool), func(i, j int) {
+		pool[i], pool[j] = pool[j], pool[i]
+	})
+	sort.SliceStable(pool, func(i, j int) bool {
+		return loads[pool[i].UserID] < loads[pool[j].UserID]
+	})
+	return pool[:min(count, len(pool))]
+}
+
+func pickWeighted(users []store.User, weights map[string]float64, count int) []store.User {
+	type keyed struct {
+		user store.User
+		key  float64
+	}
+
+	pool := make([]keyed, 0, len(users))
+	for _, user := range users {
+		weight, ok := weights[user.UserID]
+		if !ok {
+			weight = 1
+		}
+		if weight <= 0 {
+			continue
+		}
+		pool = append(pool, keyed{user: user, key: math.Pow(rand.Float64(), 1/weight)})
+	}
+
+	sort.Slice(pool, func(i, j int) bool {
+		return pool[i].key > pool[j].key
+	})
+
+	selected := make([]store.User, min(count, len(pool)))
+	for i := range selected {
+		selected[i] = pool[i].user
+	}
+	return selected
+}
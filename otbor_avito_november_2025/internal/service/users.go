@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+func WithDefaultTeam(teamName string) Option {
+	return func(s *Service) {
+		s.defaultTeam = teamName
+	}
+}
+
+func (s *Service) ValidateDefaultTeam(ctx context.Context) error {
+	if s.defaultTeam == "" {
+		return nil
+	}
+	if err := s.requireTeam(ctx, s.defaultTeam); err != nil {
+		return fmt.Errorf("default team %q: %w", s.defaultTeam, err)
+	}
+	return nil
+}
+
+type MemberUpdate struct {
+	UserID   string
+	Username *string
+	IsActive *bool
+}
+
+func (s *Service) UpdateTeamMember(ctx context.Context, teamName string, update MemberUpdate) (*store.User, error) {
+	if strings.TrimSpace(update.UserID) == "" || (update.Username != nil && strings.TrimSpace(*update.Username) == "") {
+		return nil, ErrInvalidMember
+	}
+
+	if err := s.requireTeam(ctx, teamName); err != nil {
+		return nil, err
+	}
+
+	user, err := s.store.GetUser(ctx, update.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		if update.Username == nil {
+			return nil, ErrInvalidMember
+		}
+		user = &store.User{UserID: update.UserID, IsActive: true, TeamName: teamName}
+	} else if user.TeamName != teamName {
+		return nil, ErrMemberOtherTeam
+	}
+
+	if update.Username != nil {
+		user.Username = *update.Username
+	}
+	if update.IsActive != nil {
+		user.IsActive = *update.IsActive
+	}
+	if err := s.store.CreateOrUpdateUser(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *Service) CreateOrUpdateUser(ctx context.Context, user store.User) (*store.User, error) {
+	if strings.TrimSpace(user.TeamName) == "" {
+		if s.defaultTeam == "" {
+			return nil, ErrTeamRequired
+		}
+		user.TeamName = s.defaultTeam
+	}
+
+	if err := s.requireTeam(ctx, user.TeamName); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.CreateOrUpdateUser(ctx, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
@@ -0,0 +1,412 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"otbor_avito_november_2025/internal/store"
+)
+
+const (
+	EventStatusChanged      = "pull_request.status_changed"
+	EventReviewersAssigned  = "pull_request.reviewers_assigned"
+	EventReviewerReassigned = "pull_request.reviewer_reassigned"
+	EventWebhookTest        = "webhook.test"
+
+	WebhookSignatureHeader = "X-Webhook-Signature"
+
+	webhookWildcard  = "*"
+	webhookQueueSize = 256
+
+	webhookTestTimeout = 5 * time.Second
+)
+
+type WebhookConfig struct {
+	Attempts int
+	Backoff  time.Duration
+	Timeout  time.Duration
+}
+
+type WebhookDelivery struct {
+	URL     string
+	Secret  string
+	Payload []byte
+}
+
+type StatusChangeEvent struct {
+	Event       string                  `json:"event"`
+	From        store.PullRequestStatus `json:"from"`
+	To          store.PullRequestStatus `json:"to"`
+	PullRequest store.PullRequest       `json:"pull_request"`
+	OccurredAt  time.Time               `json:"occurred_at"`
+}
+
+type AssignmentEvent struct {
+	Event           string    `json:"event"`
+	PullRequestID   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	AssignedUserIDs []string  `json:"assigned_user_ids"`
+	ReplacedUserID  string    `json:"replaced_user_id,omitempty"`
+	OccurredAt      time.Time `json:"occurred_at"`
+}
+
+type WebhookTestEvent struct {
+	Event          string    `json:"event"`
+	SubscriptionID int64     `json:"subscription_id"`
+	OccurredAt     time.Time `json:"occurred_at"`
+}
+
+type WebhookTestResult struct {
+	SubscriptionID int64
+	StatusCode     *int
+	Latency        time.Duration
+	Error          *string
+}
+
+func WithWebhookDispatcher(dispatcher *WebhookDispatcher) Option {
+	return func(s *Service) {
+		s.webhooks = dispatcher
+	}
+}
+
+func WithAssignmentWebhook(rawURL, secret string) Option {
+	return func(s *Service) {
+		s.assignmentWebhook = WebhookDelivery{URL: rawURL, Secret: secret}
+	}
+}
+
+func (s *Service) CreateWebhookSubscription(ctx context.Context, rawURL, secret string, events []string) (*store.WebhookSubscription, error) {
+	events, err := normalizeWebhook(rawURL, secret, events)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := &store.WebhookSubscription{
+		URL:       rawURL,
+		Secret:    secret,
+		Events:    events,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.store.CreateWebhookSubscription(ctx, sub); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+func (s *Service) GetWebhookSubscriptions(ctx context.Context) ([]store.WebhookSubscription, error) {
+	return s.store.GetWebhookSubscriptions(ctx)
+}
+
+func (s *Service) DeleteWebhookSubscription(ctx context.Context, id int64) error {
+	deleted, err := s.store.DeleteWebhookSubscription(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *Service) TestWebhookSubscription(ctx context.Context, id int64) (*WebhookTestResult, error) {
+	sub, err := s.store.GetWebhookSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if sub == nil {
+		return nil, ErrNotFound
+	}
+
+	payload, err := json.Marshal(WebhookTestEvent{
+		Event:          EventWebhookTest,
+		SubscriptionID: sub.ID,
+		OccurredAt:     time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookTestTimeout)
+	defer cancel()
+
+	result := &WebhookTestResult{SubscriptionID: sub.ID}
+	started := time.Now()
+	status, err := postWebhook(ctx, http.DefaultClient, WebhookDelivery{URL: sub.URL, Secret: sub.Secret, Payload: payload})
+	result.Latency = time.Since(started)
+	if err != nil {
+		message := err.Error()
+		result.Error = &message
+		return result, nil
+	}
+	result.StatusCode = &status
+	return result, nil
+}
+
+func (s *Service) notifyStatusChange(ctx context.Context, pr *store.PullRequest, from store.PullRequestStatus) {
+	if s.webhooks == nil {
+		return
+	}
+
+	subs, err := s.store.GetWebhookSubscriptions(ctx)
+	if err != nil {
+		log.Println("Failed to load webhook subscriptions:", err)
+		return
+	}
+
+	var payload []byte
+	for _, sub := range subs {
+		if !matchesTransition(sub.Events, from, pr.Status) {
+			continue
+		}
+		if payload == nil {
+			payload, err = json.Marshal(StatusChangeEvent{
+				Event:       EventStatusChanged,
+				From:        from,
+				To:          pr.Status,
+				PullRequest: *pr,
+				OccurredAt:  time.Now().UTC(),
+			})
+			if err != nil {
+				log.Println("Failed to encode webhook payload:", err)
+				return
+			}
+		}
+		s.webhooks.Enqueue(WebhookDelivery{URL: sub.URL, Secret: sub.Secret, Payload: payload})
+	}
+}
+
+func (s *Service) notifyAssignment(pr *store.PullRequest, assigned []store.User, replacedUserID string) {
+	if s.webhooks == nil || s.assignmentWebhook.URL == "" || len(assigned) == 0 {
+		return
+	}
+
+	event := AssignmentEvent{
+		Event:           EventReviewersAssigned,
+		PullRequestID:   pr.PullRequestID,
+		PullRequestName: pr.PullRequestName,
+		AssignedUserIDs: make([]string, len(assigned)),
+		ReplacedUserID:  replacedUserID,
+		OccurredAt:      time.Now().UTC(),
+	}
+	if replacedUserID != "" {
+		event.Event = EventReviewerReassigned
+	}
+	for i, user := range assigned {
+		event.AssignedUserIDs[i] = user.UserID
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Println("Failed to encode assignment webhook payload:", err)
+		return
+	}
+	delivery := s.assignmentWebhook
+	delivery.Payload = payload
+	s.webhooks.Enqueue(delivery)
+}
+
+func normalizeWebhook(rawURL, secret string, events []string) ([]string, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, ErrInvalidWebhook
+	}
+	if strings.TrimSpace(secret) == "" || len(events) == 0 {
+		return nil, ErrInvalidWebhook
+	}
+
+	normalized := make([]string, len(events))
+	for i, event := range events {
+		from, to, ok := parseTransition(event)
+		if !ok {
+			return nil, ErrInvalidWebhook
+		}
+		normalized[i] = from + "->" + to
+	}
+	return normalized, nil
+}
+
+func parseTransition(event string) (string, string, bool) {
+	from, to, found := strings.Cut(strings.ToUpper(strings.TrimSpace(event)), "->")
+	from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+	if !found || !knownWebhookStatus(from) || !knownWebhookStatus(to) {
+		return "", "", false
+	}
+	return from, to, true
+}
+
+func knownWebhookStatus(status string) bool {
+	if status == webhookWildcard {
+		return true
+	}
+	for _, known := range prStatuses {
+		if status == string(known) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesTransition(events []string, from, to store.PullRequestStatus) bool {
+	for _, event := range events {
+		eventFrom, eventTo, ok := parseTransition(event)
+		if !ok {
+			continue
+		}
+		if (eventFrom == webhookWildcard || eventFrom == string(from)) && (eventTo == webhookWildcard || eventTo == string(to)) {
+			return true
+		}
+	}
+	return false
+}
+
+type WebhookDispatcher struct {
+	config WebhookConfig
+	client *http.Client
+	queue  chan WebhookDelivery
+	stop   chan struct{}
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewWebhookDispatcher(config WebhookConfig) *WebhookDispatcher {
+	if config.Attempts <= 0 {
+		config.Attempts = 1
+	}
+	if config.Backoff <= 0 {
+		config.Backoff = time.Second
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+	return &WebhookDispatcher{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+		queue:  make(chan WebhookDelivery, webhookQueueSize),
+	}
+}
+
+func (d *WebhookDispatcher) Enqueue(delivery WebhookDelivery) {
+	select {
+	case d.queue <- delivery:
+	default:
+		log.Println("Webhook queue is full, dropping delivery to", delivery.URL)
+	}
+}
+
+func (d *WebhookDispatcher) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	d.cancel = cancel
+	d.stop = make(chan struct{})
+	d.done = make(chan struct{})
+
+	go func() {
+		defer close(d.done)
+		var wg sync.WaitGroup
+		defer wg.Wait()
+
+		dispatch := func(delivery WebhookDelivery) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				d.deliver(ctx, delivery)
+			}()
+		}
+		for {
+			select {
+			case <-d.stop:
+				for {
+					select {
+					case delivery := <-d.queue:
+						dispatch(delivery)
+					default:
+						return
+					}
+				}
+			case delivery := <-d.queue:
+				dispatch(delivery)
+			}
+		}
+	}()
+}
+
+func (d *WebhookDispatcher) Stop(ctx context.Context) {
+	if d.cancel == nil {
+		return
+	}
+	close(d.stop)
+	select {
+	case <-d.done:
+	case <-ctx.Done():
+		log.Println("Webhook queue was not drained before the shutdown deadline, cancelling pending deliveries")
+	}
+	d.cancel()
+	<-d.done
+}
+
+func (d *WebhookDispatcher) deliver(ctx context.Context, delivery WebhookDelivery) {
+	backoff := d.config.Backoff
+	for attempt := 1; ; attempt++ {
+		err := d.send(ctx, delivery)
+		if err == nil {
+			return
+		}
+		if attempt >= d.config.Attempts {
+			log.Printf("Webhook delivery to %s failed after %d attempts: %v", delivery.URL, attempt, err)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (d *WebhookDispatcher) send(ctx context.Context, delivery WebhookDelivery) error {
+	status, err := postWebhook(ctx, d.client, delivery)
+	if err != nil {
+		return err
+	}
+	if status < 200 || status >= 300 {
+		return fmt.Errorf("unexpected status %d", status)
+	}
+	return nil
+}
+
+func postWebhook(ctx context.Context, client *http.Client, delivery WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookSignatureHeader, signWebhook(delivery.Secret, delivery.Payload))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	return resp.StatusCode, nil
+}
+
+func signWebhook(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
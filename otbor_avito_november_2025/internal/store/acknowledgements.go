@@ -0,0 +1,66 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+type ReviewerAcknowledgement struct {
+	UserID         string     `json:"user_id"`
+	AcknowledgedAt *time.Time `json:"acknowledged_at"`
+}
+
+func (s *PostgresStore) AcknowledgeReview(ctx context.Context, key PRKey, userID string, now time.Time) (bool, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE pr_reviewers SET acknowledged_at = COALESCE(acknowledged_at, $4)
+		WHERE team_name = $1 AND pull_request_id = $2 AND user_id = $3
+	`
+	result, err := s.db.ExecContext(ctx, query, key.TeamName, key.PullRequestID, userID, now)
+	if err != nil {
+		return false, fmt.Errorf("acknowledge review: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("acknowledge review: %w", err)
+	}
+	return affected > 0, nil
+}
+
+func (s *PostgresStore) GetPRAcknowledgements(ctx context.Context, key PRKey) ([]ReviewerAcknowledgement, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT user_id, acknowledged_at
+		FROM pr_reviewers
+		WHERE team_name = $1 AND pull_request_id = $2
+		ORDER BY user_id
+	`
+	rows, err := s.db.QueryContext(ctx, query, key.TeamName, key.PullRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("get PR acknowledgements: %w", err)
+	}
+	defer rows.Close()
+
+	var acks []ReviewerAcknowledgement
+	for rows.Next() {
+		var ack ReviewerAcknowledgement
+		var acknowledgedAt sql.NullTime
+		if err := rows.Scan(&ack.UserID, &acknowledgedAt); err != nil {
+			return nil, fmt.Errorf("get PR acknowledgements: %w", err)
+		}
+		if acknowledgedAt.Valid {
+			ack.AcknowledgedAt = &acknowledgedAt.Time
+		}
+		acks = append(acks, ack)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get PR acknowledgements: %w", err)
+	}
+	return acks, nil
+}
@@ -0,0 +1,68 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+func (s *PostgresStore) ReplaceUserAPIKey(ctx context.Context, userID, keyHash string, createdAt time.Time) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("replace user API key: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := revokeUserAPIKeys(ctx, tx, userID, createdAt); err != nil {
+		return fmt.Errorf("replace user API key: %w", err)
+	}
+	query := `INSERT INTO user_api_keys (key_hash, user_id, created_at) VALUES ($1, $2, $3)`
+	if _, err := tx.ExecContext(ctx, query, keyHash, userID, createdAt); err != nil {
+		return fmt.Errorf("replace user API key: %w", duplicateKeyError(err))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit replace user API key: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) RevokeUserAPIKeys(ctx context.Context, userID string, revokedAt time.Time) (int64, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+	return revokeUserAPIKeys(ctx, s.db, userID, revokedAt)
+}
+
+func (s *PostgresStore) GetAPIKeyUser(ctx context.Context, keyHash string, usedAt time.Time) (string, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE user_api_keys SET last_used_at = $2
+		WHERE key_hash = $1 AND revoked_at IS NULL
+		RETURNING user_id
+	`
+	var userID string
+	err := s.db.QueryRowContext(ctx, query, keyHash, usedAt).Scan(&userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("get API key user: %w", err)
+	}
+	return userID, nil
+}
+
+func revokeUserAPIKeys(ctx context.Context, db execer, userID string, revokedAt time.Time) (int64, error) {
+	query := `UPDATE user_api_keys SET revoked_at = $2 WHERE user_id = $1 AND revoked_at IS NULL`
+	result, err := db.ExecContext(ctx, query, userID, revokedAt)
+	if err != nil {
+		return 0, fmt.Errorf("revoke user API keys: %w", err)
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,83 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type Approval struct {
+	UserID     string    `json:"user_id"`
+	ApprovedAt time.Time `json:"approved_at"`
+}
+
+func (s *PostgresStore) ApprovePR(ctx context.Context, key PRKey, userID string, now time.Time) (bool, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO pr_approvals (team_name, pull_request_id, user_id, approved_at)
+		SELECT team_name, pull_request_id, user_id, $4
+		FROM pr_reviewers
+		WHERE team_name = $1 AND pull_request_id = $2 AND user_id = $3
+		ON CONFLICT (team_name, pull_request_id, user_id) DO UPDATE SET approved_at = EXCLUDED.approved_at
+	`
+	result, err := s.db.ExecContext(ctx, query, key.TeamName, key.PullRequestID, userID, now)
+	if err != nil {
+		return false, fmt.Errorf("approve PR: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("approve PR: %w", err)
+	}
+	return affected > 0, nil
+}
+
+func (s *PostgresStore) GetPRApprovals(ctx context.Context, key PRKey) ([]Approval, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT a.user_id, a.approved_at
+		FROM pr_approvals a
+		JOIN pr_reviewers r ON r.team_name = a.team_name AND r.pull_request_id = a.pull_request_id AND r.user_id = a.user_id
+		WHERE a.team_name = $1 AND a.pull_request_id = $2 AND a.approved_at >= r.assigned_at
+		ORDER BY a.approved_at, a.user_id
+	`
+	rows, err := s.db.QueryContext(ctx, query, key.TeamName, key.PullRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("get PR approvals: %w", err)
+	}
+	defer rows.Close()
+
+	var approvals []Approval
+	for rows.Next() {
+		var approval Approval
+		if err := rows.Scan(&approval.UserID, &approval.ApprovedAt); err != nil {
+			return nil, fmt.Errorf("get PR approvals: %w", err)
+		}
+		approvals = append(approvals, approval)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get PR approvals: %w", err)
+	}
+	return approvals, nil
+}
+
+func (s *PostgresStore) CountPRApprovals(ctx context.Context, key PRKey) (int, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT COUNT(*)
+		FROM pr_approvals a
+		JOIN pr_reviewers r ON r.team_name = a.team_name AND r.pull_request_id = a.pull_request_id AND r.user_id = a.user_id
+		WHERE a.team_name = $1 AND a.pull_request_id = $2 AND a.approved_at >= r.assigned_at
+	`
+	var count int
+	err := s.db.QueryRowContext(ctx, query, key.TeamName, key.PullRequestID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("count PR approvals: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,154 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+type AssignmentReason struct {
+	Reason   string `json:"reason"`
+	Strategy string `json:"strategy"`
+	Detail   string `json:"detail"`
+}
+
+type ReviewerAssignment struct {
+	UserID           string
+	AssignedAt       time.Time
+	Reason           AssignmentReason
+	LoadAtAssignment *int
+}
+
+type Assignment struct {
+	PullRequest PullRequest
+	AssignedAt  time.Time
+}
+
+func (s *PostgresStore) GetUserAssignments(ctx context.Context, userID string, since, until time.Time, limit, offset int) ([]Assignment, int, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM pr_reviewers WHERE user_id = $1 AND assigned_at >= $2 AND assigned_at < $3`
+	if err := s.db.QueryRowContext(ctx, countQuery, userID, since, until).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("get user assignments: %w", err)
+	}
+
+	query := `
+		SELECT ` + prColumnsAliased + `, r.assigned_at
+		FROM pr_reviewers r
+		JOIN pull_requests p ON p.team_name = r.team_name AND p.pull_request_id = r.pull_request_id
+		WHERE r.user_id = $1 AND r.assigned_at >= $2 AND r.assigned_at < $3
+		ORDER BY r.assigned_at DESC, p.pull_request_id
+		LIMIT $4 OFFSET $5
+	`
+	rows, err := s.db.QueryContext(ctx, query, userID, since, until, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get user assignments: %w", err)
+	}
+	defer rows.Close()
+
+	var assignments []Assignment
+	for rows.Next() {
+		var assignment Assignment
+		pr, err := scanPR(withExtra(rows, &assignment.AssignedAt))
+		if err != nil {
+			return nil, 0, fmt.Errorf("get user assignments: %w", err)
+		}
+		assignment.PullRequest = *pr
+		assignments = append(assignments, assignment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("get user assignments: %w", err)
+	}
+	return assignments, total, nil
+}
+
+type extraScanner struct {
+	row   rowScanner
+	extra []interface{}
+}
+
+func withExtra(row rowScanner, extra ...interface{}) rowScanner {
+	return extraScanner{row: row, extra: extra}
+}
+
+func (e extraScanner) Scan(dest ...interface{}) error {
+	return e.row.Scan(append(dest, e.extra...)...)
+}
+
+func (s *PostgresStore) GetPRAssignmentReasons(ctx context.Context, key PRKey) ([]ReviewerAssignment, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT user_id, assigned_at, COALESCE(assignment_reason, ''), COALESCE(assignment_strategy, ''),
+		       COALESCE(assignment_detail, ''), load_at_assignment
+		FROM pr_reviewers
+		WHERE team_name = $1 AND pull_request_id = $2
+		ORDER BY assigned_at, user_id
+	`
+	rows, err := s.db.QueryContext(ctx, query, key.TeamName, key.PullRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("get PR assignment reasons: %w", err)
+	}
+	defer rows.Close()
+
+	var assignments []ReviewerAssignment
+	for rows.Next() {
+		var a ReviewerAssignment
+		var load sql.NullInt64
+		if err := rows.Scan(&a.UserID, &a.AssignedAt, &a.Reason.Reason, &a.Reason.Strategy, &a.Reason.Detail, &load); err != nil {
+			return nil, fmt.Errorf("get PR assignment reasons: %w", err)
+		}
+		if load.Valid {
+			n := int(load.Int64)
+			a.LoadAtAssignment = &n
+		}
+		assignments = append(assignments, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get PR assignment reasons: %w", err)
+	}
+	return assignments, nil
+}
+
+type ReviewInterval struct {
+	Start time.Time
+	End   *time.Time
+}
+
+func (s *PostgresStore) GetUserReviewIntervals(ctx context.Context, userID string, since time.Time) ([]ReviewInterval, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT r.assigned_at, p.merged_at
+		FROM pr_reviewers r
+		JOIN pull_requests p ON p.team_name = r.team_name AND p.pull_request_id = r.pull_request_id
+		WHERE r.user_id = $1 AND (p.merged_at IS NULL OR p.merged_at > $2)
+	`
+	rows, err := s.db.QueryContext(ctx, query, userID, since)
+	if err != nil {
+		return nil, fmt.Errorf("get user review intervals: %w", err)
+	}
+	defer rows.Close()
+
+	var intervals []ReviewInterval
+	for rows.Next() {
+		var interval ReviewInterval
+		var end sql.NullTime
+		if err := rows.Scan(&interval.Start, &end); err != nil {
+			return nil, fmt.Errorf("get user review intervals: %w", err)
+		}
+		if end.Valid {
+			interval.End = &end.Time
+		}
+		intervals = append(intervals, interval)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get user review intervals: %w", err)
+	}
+	return intervals, nil
+}
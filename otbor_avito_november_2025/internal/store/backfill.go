@@ -0,0 +1,88 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type PlaceholderUser struct {
+	UserID       string `json:"user_id"`
+	TeamName     string `json:"team_name"`
+	PullRequests int    `json:"pull_requests"`
+}
+
+func (s *PostgresStore) BackfillAuthors(ctx context.Context, fallbackTeam string, batchSize int) ([]PlaceholderUser, error) {
+	var created []PlaceholderUser
+	for {
+		batch, err := s.backfillAuthorsBatch(ctx, fallbackTeam, batchSize)
+		if err != nil {
+			return created, fmt.Errorf("backfill authors: %w", err)
+		}
+		created = append(created, batch...)
+		if len(batch) < batchSize {
+			return created, nil
+		}
+	}
+}
+
+func (s *PostgresStore) backfillAuthorsBatch(ctx context.Context, fallbackTeam string, batchSize int) ([]PlaceholderUser, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("backfill authors batch: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		SELECT p.author_id, COALESCE(MIN(p.team_name), $1), COUNT(*)
+		FROM pull_requests p
+		LEFT JOIN users u ON u.user_id = p.author_id
+		WHERE u.user_id IS NULL
+		GROUP BY p.author_id
+		ORDER BY p.author_id
+		LIMIT $2
+	`
+	rows, err := tx.QueryContext(ctx, query, fallbackTeam, batchSize)
+	if err != nil {
+		return nil, fmt.Errorf("backfill authors batch: %w", err)
+	}
+
+	var batch []PlaceholderUser
+	for rows.Next() {
+		var user PlaceholderUser
+		if err := rows.Scan(&user.UserID, &user.TeamName, &user.PullRequests); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("backfill authors batch: %w", err)
+		}
+		batch = append(batch, user)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("backfill authors batch: %w", err)
+	}
+
+	now := time.Now()
+	for _, user := range batch {
+		if user.TeamName == fallbackTeam {
+			if _, err := tx.ExecContext(ctx, `INSERT INTO teams (name, created_at) VALUES ($1, $2) ON CONFLICT (name) DO NOTHING`, fallbackTeam, now); err != nil {
+				return nil, fmt.Errorf("backfill authors batch: %w", err)
+			}
+		}
+		insert := `
+			INSERT INTO users (user_id, username, is_active, team_name, created_at)
+			VALUES ($1, $1, false, $2, $3)
+			ON CONFLICT (user_id) DO NOTHING
+		`
+		if _, err := tx.ExecContext(ctx, insert, user.UserID, user.TeamName, now); err != nil {
+			return nil, fmt.Errorf("backfill authors batch: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit backfill authors batch: %w", err)
+	}
+	return batch, nil
+}
@@ -0,0 +1,57 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type BlackoutWindow struct {
+	ID         int64     `json:"id"`
+	TeamName   string    `json:"team_name"`
+	StartsAt   time.Time `json:"starts_at"`
+	EndsAt     time.Time `json:"ends_at"`
+	Recurrence string    `json:"recurrence"`
+}
+
+func (s *PostgresStore) GetBlackoutWindows(ctx context.Context, teamName string) ([]BlackoutWindow, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, team_name, starts_at, ends_at, recurrence
+		FROM team_blackouts
+		WHERE team_name = $1
+		ORDER BY starts_at, id
+	`
+	rows, err := s.db.QueryContext(ctx, query, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("get blackout windows: %w", err)
+	}
+	defer rows.Close()
+
+	var windows []BlackoutWindow
+	for rows.Next() {
+		var window BlackoutWindow
+		if err := rows.Scan(&window.ID, &window.TeamName, &window.StartsAt, &window.EndsAt, &window.Recurrence); err != nil {
+			return nil, fmt.Errorf("get blackout windows: %w", err)
+		}
+		windows = append(windows, window)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get blackout windows: %w", err)
+	}
+	return windows, nil
+}
+
+func (s *PostgresStore) CreateBlackoutWindow(ctx context.Context, window *BlackoutWindow) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO team_blackouts (team_name, starts_at, ends_at, recurrence)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`
+	return s.db.QueryRowContext(ctx, query, window.TeamName, window.StartsAt, window.EndsAt, window.Recurrence).Scan(&window.ID)
+}
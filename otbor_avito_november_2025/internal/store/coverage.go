@@ -0,0 +1,47 @@
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+type ReviewerCount struct {
+	PullRequest PullRequest
+	Reviewers   int
+}
+
+func (s *PostgresStore) GetUnderReviewedPRs(ctx context.Context, teamName string, required int) ([]ReviewerCount, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + prColumnsAliased + `, COUNT(r.user_id)
+		FROM pull_requests p
+		JOIN users a ON a.user_id = p.author_id
+		LEFT JOIN pr_reviewers r ON r.team_name = p.team_name AND r.pull_request_id = p.pull_request_id
+		WHERE a.team_name = $1 AND p.status = $2
+		GROUP BY p.team_name, p.pull_request_id
+		HAVING COUNT(r.user_id) < $3
+		ORDER BY p.created_at, p.pull_request_id
+	`
+	rows, err := s.db.QueryContext(ctx, query, teamName, PRStatusOpen, required)
+	if err != nil {
+		return nil, fmt.Errorf("get under reviewed PRs: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []ReviewerCount
+	for rows.Next() {
+		var count ReviewerCount
+		pr, err := scanPR(withExtra(rows, &count.Reviewers))
+		if err != nil {
+			return nil, fmt.Errorf("get under reviewed PRs: %w", err)
+		}
+		count.PullRequest = *pr
+		counts = append(counts, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get under reviewed PRs: %w", err)
+	}
+	return counts, nil
+}
@@ -0,0 +1,49 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func (s *PostgresStore) GetOverduePRs(ctx context.Context, now time.Time) ([]PullRequest, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + prColumnsAliased + `
+		FROM pull_requests p
+		JOIN users author ON author.user_id = p.author_id
+		JOIN teams t ON t.name = COALESCE(p.team_name, author.team_name)
+		WHERE p.status = $1
+		  AND p.review_deadline IS NOT NULL
+		  AND p.review_deadline < $2
+		  AND NOT EXISTS (SELECT 1 FROM pr_escalations e WHERE e.team_name = p.team_name AND e.pull_request_id = p.pull_request_id)
+		  AND (
+			SELECT COUNT(*)
+			FROM pr_approvals a
+			JOIN pr_reviewers r ON r.team_name = a.team_name AND r.pull_request_id = a.pull_request_id AND r.user_id = a.user_id
+			WHERE a.team_name = p.team_name AND a.pull_request_id = p.pull_request_id AND a.approved_at >= r.assigned_at
+		  ) < t.required_reviewers
+		ORDER BY p.review_deadline
+	`
+	rows, err := s.db.QueryContext(ctx, query, PRStatusOpen, now)
+	if err != nil {
+		return nil, fmt.Errorf("get overdue PRs: %w", err)
+	}
+	defer rows.Close()
+
+	return s.scanPRs(rows)
+}
+
+func (s *PostgresStore) RecordEscalation(ctx context.Context, key PRKey, userID string) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `INSERT INTO pr_escalations (team_name, pull_request_id, user_id, escalated_at) VALUES ($1, $2, $3, $4)`
+	_, err := s.db.ExecContext(ctx, query, key.TeamName, key.PullRequestID, userID, time.Now())
+	if err != nil {
+		return fmt.Errorf("record escalation: %w", duplicateKeyError(err))
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+type ReviewExportRow struct {
+	PullRequestID string     `json:"pr_id"`
+	ReviewerID    string     `json:"reviewer_id"`
+	ReviewState   string     `json:"review_state"`
+	AssignedAt    time.Time  `json:"assigned_at"`
+	ReviewedAt    *time.Time `json:"reviewed_at"`
+}
+
+func (s *PostgresStore) StreamReviewExport(ctx context.Context, since, until time.Time, fn func(ReviewExportRow) error) error {
+	query := `
+		SELECT r.pull_request_id, r.user_id,
+		       CASE
+		           WHEN p.status = $3 THEN 'COMPLETED'
+		           WHEN r.acknowledged_at IS NOT NULL THEN 'ACKNOWLEDGED'
+		           ELSE 'PENDING'
+		       END,
+		       r.assigned_at, p.merged_at
+		FROM pr_reviewers r
+		JOIN pull_requests p ON p.team_name = r.team_name AND p.pull_request_id = r.pull_request_id
+		WHERE r.assigned_at >= $1 AND r.assigned_at < $2
+		ORDER BY r.assigned_at, r.pull_request_id, r.user_id
+	`
+	rows, err := s.db.QueryContext(ctx, query, since, until, PRStatusMerged)
+	if err != nil {
+		return fmt.Errorf("stream review export: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row ReviewExportRow
+		var reviewedAt sql.NullTime
+		if err := rows.Scan(&row.PullRequestID, &row.ReviewerID, &row.ReviewState, &row.AssignedAt, &reviewedAt); err != nil {
+			return fmt.Errorf("stream review export: %w", err)
+		}
+		if reviewedAt.Valid {
+			row.ReviewedAt = &reviewedAt.Time
+		}
+		if err := fn(row); err != nil {
+			return fmt.Errorf("stream review export: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("stream review export: %w", err)
+	}
+	return nil
+}
+
+type ReviewMatrixCell struct {
+	AuthorID    string `json:"author_id"`
+	Assignments int    `json:"assignments"`
+}
+
+type ReviewMatrixRow struct {
+	UserID   string             `json:"user_id"`
+	Username string             `json:"username"`
+	TeamName string             `json:"team_name"`
+	IsActive bool               `json:"is_active"`
+	Authors  []ReviewMatrixCell `json:"authors"`
+}
+
+func (s *PostgresStore) StreamReviewMatrix(ctx context.Context, fn func(ReviewMatrixRow) error) error {
+	query := `
+		SELECT u.user_id, u.username, u.team_name, u.is_active, p.author_id, COUNT(p.pull_request_id)
+		FROM users u
+		LEFT JOIN pr_reviewers r ON r.user_id = u.user_id
+		LEFT JOIN pull_requests p ON p.team_name = r.team_name AND p.pull_request_id = r.pull_request_id
+		GROUP BY u.user_id, u.username, u.team_name, u.is_active, p.author_id
+		ORDER BY u.user_id, p.author_id
+	`
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("stream review matrix: %w", err)
+	}
+	defer rows.Close()
+
+	var current *ReviewMatrixRow
+	for rows.Next() {
+		var row ReviewMatrixRow
+		var authorID sql.NullString
+		var assignments int
+		if err := rows.Scan(&row.UserID, &row.Username, &row.TeamName, &row.IsActive, &authorID, &assignments); err != nil {
+			return fmt.Errorf("stream review matrix: %w", err)
+		}
+		if current != nil && current.UserID != row.UserID {
+			if err := fn(*current); err != nil {
+				return fmt.Errorf("stream review matrix: %w", err)
+			}
+			current = nil
+		}
+		if current == nil {
+			row.Authors = []ReviewMatrixCell{}
+			current = &row
+		}
+		if authorID.Valid {
+			current.Authors = append(current.Authors, ReviewMatrixCell{AuthorID: authorID.String, Assignments: assignments})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("stream review matrix: %w", err)
+	}
+	if current != nil {
+		return fn(*current)
+	}
+	return nil
+}
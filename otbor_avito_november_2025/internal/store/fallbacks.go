@@ -0,0 +1,62 @@
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+func (s *PostgresStore) GetFallbackTeams(ctx context.Context, teamName string) ([]string, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT fallback_team_name
+		FROM team_fallbacks
+		WHERE team_name = $1
+		ORDER BY fallback_team_name
+	`
+	rows, err := s.db.QueryContext(ctx, query, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("get fallback teams: %w", err)
+	}
+	defer rows.Close()
+
+	var teams []string
+	for rows.Next() {
+		var team string
+		if err := rows.Scan(&team); err != nil {
+			return nil, fmt.Errorf("get fallback teams: %w", err)
+		}
+		teams = append(teams, team)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get fallback teams: %w", err)
+	}
+	return teams, nil
+}
+
+func (s *PostgresStore) ReplaceFallbackTeams(ctx context.Context, teamName string, fallbackTeams []string) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("replace fallback teams: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM team_fallbacks WHERE team_name = $1`, teamName); err != nil {
+		return fmt.Errorf("replace fallback teams: %w", err)
+	}
+	for _, fallback := range fallbackTeams {
+		query := `INSERT INTO team_fallbacks (team_name, fallback_team_name) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+		if _, err := tx.ExecContext(ctx, query, teamName, fallback); err != nil {
+			return fmt.Errorf("replace fallback teams: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit replace fallback teams: %w", err)
+	}
+	return nil
+}
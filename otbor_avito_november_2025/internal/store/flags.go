@@ -0,0 +1,32 @@
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+func (s *PostgresStore) GetFeatureFlags(ctx context.Context) (map[string]bool, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `SELECT name, enabled FROM feature_flags`
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("get feature flags: %w", err)
+	}
+	defer rows.Close()
+
+	flags := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		var enabled bool
+		if err := rows.Scan(&name, &enabled); err != nil {
+			return nil, fmt.Errorf("get feature flags: %w", err)
+		}
+		flags[name] = enabled
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get feature flags: %w", err)
+	}
+	return flags, nil
+}
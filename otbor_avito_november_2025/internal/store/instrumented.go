@@ -0,0 +1,438 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+type ObserveFunc func(method string, elapsed time.Duration)
+
+type InstrumentedStore struct {
+	next    Store
+	observe ObserveFunc
+}
+
+var _ Store = (*InstrumentedStore)(nil)
+
+func NewInstrumentedStore(next Store, observe ObserveFunc) *InstrumentedStore {
+	return &InstrumentedStore{next: next, observe: observe}
+}
+
+func (s *InstrumentedStore) since(method string, started time.Time) {
+	s.observe(method, time.Since(started))
+}
+
+func (s *InstrumentedStore) CreateTeam(ctx context.Context, team *Team) error {
+	defer s.since("CreateTeam", time.Now())
+	return s.next.CreateTeam(ctx, team)
+}
+
+func (s *InstrumentedStore) CreateTeamWithMembers(ctx context.Context, team *Team, members []User) error {
+	defer s.since("CreateTeamWithMembers", time.Now())
+	return s.next.CreateTeamWithMembers(ctx, team, members)
+}
+
+func (s *InstrumentedStore) UpdateTeamWithMembers(ctx context.Context, team *Team, members []User) error {
+	defer s.since("UpdateTeamWithMembers", time.Now())
+	return s.next.UpdateTeamWithMembers(ctx, team, members)
+}
+
+func (s *InstrumentedStore) GetTeam(ctx context.Context, name string) (*Team, error) {
+	defer s.since("GetTeam", time.Now())
+	return s.next.GetTeam(ctx, name)
+}
+
+func (s *InstrumentedStore) GetTeamMembers(ctx context.Context, teamName string) ([]User, error) {
+	defer s.since("GetTeamMembers", time.Now())
+	return s.next.GetTeamMembers(ctx, teamName)
+}
+
+func (s *InstrumentedStore) CreateOrUpdateUser(ctx context.Context, user *User) error {
+	defer s.since("CreateOrUpdateUser", time.Now())
+	return s.next.CreateOrUpdateUser(ctx, user)
+}
+
+func (s *InstrumentedStore) GetUser(ctx context.Context, userID string) (*User, error) {
+	defer s.since("GetUser", time.Now())
+	return s.next.GetUser(ctx, userID)
+}
+
+func (s *InstrumentedStore) UpdateUser(ctx context.Context, user *User) error {
+	defer s.since("UpdateUser", time.Now())
+	return s.next.UpdateUser(ctx, user)
+}
+
+func (s *InstrumentedStore) GetActiveTeamMembers(ctx context.Context, teamName string, excludeUserID *string) ([]User, error) {
+	defer s.since("GetActiveTeamMembers", time.Now())
+	return s.next.GetActiveTeamMembers(ctx, teamName, excludeUserID)
+}
+
+func (s *InstrumentedStore) CreatePR(ctx context.Context, pr *PullRequest) error {
+	defer s.since("CreatePR", time.Now())
+	return s.next.CreatePR(ctx, pr)
+}
+
+func (s *InstrumentedStore) CreatePRWithReviewers(ctx context.Context, pr *PullRequest, reviewers []ReviewerAssignment) (map[string]int, error) {
+	defer s.since("CreatePRWithReviewers", time.Now())
+	return s.next.CreatePRWithReviewers(ctx, pr, reviewers)
+}
+
+func (s *InstrumentedStore) GetPR(ctx context.Context, key PRKey) (*PullRequest, error) {
+	defer s.since("GetPR", time.Now())
+	return s.next.GetPR(ctx, key)
+}
+
+func (s *InstrumentedStore) UpdatePR(ctx context.Context, pr *PullRequest) error {
+	defer s.since("UpdatePR", time.Now())
+	return s.next.UpdatePR(ctx, pr)
+}
+
+func (s *InstrumentedStore) AssignReviewer(ctx context.Context, key PRKey, userID string, reason AssignmentReason) (int, bool, error) {
+	defer s.since("AssignReviewer", time.Now())
+	return s.next.AssignReviewer(ctx, key, userID, reason)
+}
+
+func (s *InstrumentedStore) GetPRReviewers(ctx context.Context, key PRKey) ([]User, error) {
+	defer s.since("GetPRReviewers", time.Now())
+	return s.next.GetPRReviewers(ctx, key)
+}
+
+func (s *InstrumentedStore) GetRecentAuthorReviewers(ctx context.Context, authorID string, limit int) ([]string, error) {
+	defer s.since("GetRecentAuthorReviewers", time.Now())
+	return s.next.GetRecentAuthorReviewers(ctx, authorID, limit)
+}
+
+func (s *InstrumentedStore) RemoveReviewer(ctx context.Context, key PRKey, userID string) error {
+	defer s.since("RemoveReviewer", time.Now())
+	return s.next.RemoveReviewer(ctx, key, userID)
+}
+
+func (s *InstrumentedStore) GetUserAssignedPRs(ctx context.Context, userID string, status *PullRequestStatus, limit, offset int) ([]PullRequest, int, error) {
+	defer s.since("GetUserAssignedPRs", time.Now())
+	return s.next.GetUserAssignedPRs(ctx, userID, status, limit, offset)
+}
+
+func (s *InstrumentedStore) AcknowledgeReview(ctx context.Context, key PRKey, userID string, now time.Time) (bool, error) {
+	defer s.since("AcknowledgeReview", time.Now())
+	return s.next.AcknowledgeReview(ctx, key, userID, now)
+}
+
+func (s *InstrumentedStore) GetPRAcknowledgements(ctx context.Context, key PRKey) ([]ReviewerAcknowledgement, error) {
+	defer s.since("GetPRAcknowledgements", time.Now())
+	return s.next.GetPRAcknowledgements(ctx, key)
+}
+
+func (s *InstrumentedStore) ApprovePR(ctx context.Context, key PRKey, userID string, now time.Time) (bool, error) {
+	defer s.since("ApprovePR", time.Now())
+	return s.next.ApprovePR(ctx, key, userID, now)
+}
+
+func (s *InstrumentedStore) GetPRApprovals(ctx context.Context, key PRKey) ([]Approval, error) {
+	defer s.since("GetPRApprovals", time.Now())
+	return s.next.GetPRApprovals(ctx, key)
+}
+
+func (s *InstrumentedStore) CountPRApprovals(ctx context.Context, key PRKey) (int, error) {
+	defer s.since("CountPRApprovals", time.Now())
+	return s.next.CountPRApprovals(ctx, key)
+}
+
+func (s *InstrumentedStore) ReplaceUserAPIKey(ctx context.Context, userID, keyHash string, createdAt time.Time) error {
+	defer s.since("ReplaceUserAPIKey", time.Now())
+	return s.next.ReplaceUserAPIKey(ctx, userID, keyHash, createdAt)
+}
+
+func (s *InstrumentedStore) RevokeUserAPIKeys(ctx context.Context, userID string, revokedAt time.Time) (int64, error) {
+	defer s.since("RevokeUserAPIKeys", time.Now())
+	return s.next.RevokeUserAPIKeys(ctx, userID, revokedAt)
+}
+
+func (s *InstrumentedStore) GetAPIKeyUser(ctx context.Context, keyHash string, usedAt time.Time) (string, error) {
+	defer s.since("GetAPIKeyUser", time.Now())
+	return s.next.GetAPIKeyUser(ctx, keyHash, usedAt)
+}
+
+func (s *InstrumentedStore) GetUserAssignments(ctx context.Context, userID string, since, until time.Time, limit, offset int) ([]Assignment, int, error) {
+	defer s.since("GetUserAssignments", time.Now())
+	return s.next.GetUserAssignments(ctx, userID, since, until, limit, offset)
+}
+
+func (s *InstrumentedStore) GetPRAssignmentReasons(ctx context.Context, key PRKey) ([]ReviewerAssignment, error) {
+	defer s.since("GetPRAssignmentReasons", time.Now())
+	return s.next.GetPRAssignmentReasons(ctx, key)
+}
+
+func (s *InstrumentedStore) GetUserReviewIntervals(ctx context.Context, userID string, since time.Time) ([]ReviewInterval, error) {
+	defer s.since("GetUserReviewIntervals", time.Now())
+	return s.next.GetUserReviewIntervals(ctx, userID, since)
+}
+
+func (s *InstrumentedStore) GetBlackoutWindows(ctx context.Context, teamName string) ([]BlackoutWindow, error) {
+	defer s.since("GetBlackoutWindows", time.Now())
+	return s.next.GetBlackoutWindows(ctx, teamName)
+}
+
+func (s *InstrumentedStore) CreateBlackoutWindow(ctx context.Context, window *BlackoutWindow) error {
+	defer s.since("CreateBlackoutWindow", time.Now())
+	return s.next.CreateBlackoutWindow(ctx, window)
+}
+
+func (s *InstrumentedStore) GetUnderReviewedPRs(ctx context.Context, teamName string, required int) ([]ReviewerCount, error) {
+	defer s.since("GetUnderReviewedPRs", time.Now())
+	return s.next.GetUnderReviewedPRs(ctx, teamName, required)
+}
+
+func (s *InstrumentedStore) GetOverduePRs(ctx context.Context, now time.Time) ([]PullRequest, error) {
+	defer s.since("GetOverduePRs", time.Now())
+	return s.next.GetOverduePRs(ctx, now)
+}
+
+func (s *InstrumentedStore) RecordEscalation(ctx context.Context, key PRKey, userID string) error {
+	defer s.since("RecordEscalation", time.Now())
+	return s.next.RecordEscalation(ctx, key, userID)
+}
+
+func (s *InstrumentedStore) StreamReviewExport(ctx context.Context, since, until time.Time, fn func(ReviewExportRow) error) error {
+	defer s.since("StreamReviewExport", time.Now())
+	return s.next.StreamReviewExport(ctx, since, until, fn)
+}
+
+func (s *InstrumentedStore) StreamReviewMatrix(ctx context.Context, fn func(ReviewMatrixRow) error) error {
+	defer s.since("StreamReviewMatrix", time.Now())
+	return s.next.StreamReviewMatrix(ctx, fn)
+}
+
+func (s *InstrumentedStore) GetFeatureFlags(ctx context.Context) (map[string]bool, error) {
+	defer s.since("GetFeatureFlags", time.Now())
+	return s.next.GetFeatureFlags(ctx)
+}
+
+func (s *InstrumentedStore) FixPRStatusInconsistencies(ctx context.Context, dryRun bool) ([]PRStatusFix, error) {
+	defer s.since("FixPRStatusInconsistencies", time.Now())
+	return s.next.FixPRStatusInconsistencies(ctx, dryRun)
+}
+
+func (s *InstrumentedStore) GetInactiveReviewerAssignments(ctx context.Context) ([]InactiveAssignment, error) {
+	defer s.since("GetInactiveReviewerAssignments", time.Now())
+	return s.next.GetInactiveReviewerAssignments(ctx)
+}
+
+func (s *InstrumentedStore) GetSelfReviews(ctx context.Context) ([]SelfReview, error) {
+	defer s.since("GetSelfReviews", time.Now())
+	return s.next.GetSelfReviews(ctx)
+}
+
+func (s *InstrumentedStore) RemoveSelfReviews(ctx context.Context) ([]SelfReview, error) {
+	defer s.since("RemoveSelfReviews", time.Now())
+	return s.next.RemoveSelfReviews(ctx)
+}
+
+func (s *InstrumentedStore) GetPathOwners(ctx context.Context, teamName string) ([]PathOwner, error) {
+	defer s.since("GetPathOwners", time.Now())
+	return s.next.GetPathOwners(ctx, teamName)
+}
+
+func (s *InstrumentedStore) ReplacePathOwners(ctx context.Context, teamName string, owners []PathOwner) error {
+	defer s.since("ReplacePathOwners", time.Now())
+	return s.next.ReplacePathOwners(ctx, teamName, owners)
+}
+
+func (s *InstrumentedStore) GetFallbackTeams(ctx context.Context, teamName string) ([]string, error) {
+	defer s.since("GetFallbackTeams", time.Now())
+	return s.next.GetFallbackTeams(ctx, teamName)
+}
+
+func (s *InstrumentedStore) ReplaceFallbackTeams(ctx context.Context, teamName string, fallbackTeams []string) error {
+	defer s.since("ReplaceFallbackTeams", time.Now())
+	return s.next.ReplaceFallbackTeams(ctx, teamName, fallbackTeams)
+}
+
+func (s *InstrumentedStore) EnqueuePendingAssignment(ctx context.Context, key PRKey, nextAttemptAt, expiresAt time.Time) error {
+	defer s.since("EnqueuePendingAssignment", time.Now())
+	return s.next.EnqueuePendingAssignment(ctx, key, nextAttemptAt, expiresAt)
+}
+
+func (s *InstrumentedStore) GetDuePendingAssignments(ctx context.Context, now time.Time) ([]PendingAssignment, error) {
+	defer s.since("GetDuePendingAssignments", time.Now())
+	return s.next.GetDuePendingAssignments(ctx, now)
+}
+
+func (s *InstrumentedStore) GetPendingAssignments(ctx context.Context) ([]PendingAssignment, error) {
+	defer s.since("GetPendingAssignments", time.Now())
+	return s.next.GetPendingAssignments(ctx)
+}
+
+func (s *InstrumentedStore) GetPendingAssignment(ctx context.Context, key PRKey) (*PendingAssignment, error) {
+	defer s.since("GetPendingAssignment", time.Now())
+	return s.next.GetPendingAssignment(ctx, key)
+}
+
+func (s *InstrumentedStore) ReschedulePendingAssignment(ctx context.Context, key PRKey, nextAttemptAt time.Time) error {
+	defer s.since("ReschedulePendingAssignment", time.Now())
+	return s.next.ReschedulePendingAssignment(ctx, key, nextAttemptAt)
+}
+
+func (s *InstrumentedStore) DeletePendingAssignment(ctx context.Context, key PRKey) error {
+	defer s.since("DeletePendingAssignment", time.Now())
+	return s.next.DeletePendingAssignment(ctx, key)
+}
+
+func (s *InstrumentedStore) GetWeeklyQuotaUsage(ctx context.Context, userIDs []string, weekStart time.Time) (map[string]QuotaUsage, error) {
+	defer s.since("GetWeeklyQuotaUsage", time.Now())
+	return s.next.GetWeeklyQuotaUsage(ctx, userIDs, weekStart)
+}
+
+func (s *InstrumentedStore) SetUserWeeklyQuota(ctx context.Context, userID string, quota *int) error {
+	defer s.since("SetUserWeeklyQuota", time.Now())
+	return s.next.SetUserWeeklyQuota(ctx, userID, quota)
+}
+
+func (s *InstrumentedStore) SetTeamDefaultWeeklyQuota(ctx context.Context, teamName string, quota *int) error {
+	defer s.since("SetTeamDefaultWeeklyQuota", time.Now())
+	return s.next.SetTeamDefaultWeeklyQuota(ctx, teamName, quota)
+}
+
+func (s *InstrumentedStore) ReplaceReviewer(ctx context.Context, key PRKey, oldUserID, newUserID string, reason AssignmentReason) (bool, error) {
+	defer s.since("ReplaceReviewer", time.Now())
+	return s.next.ReplaceReviewer(ctx, key, oldUserID, newUserID, reason)
+}
+
+func (s *InstrumentedStore) GetReassignmentEvents(ctx context.Context, key PRKey) ([]ReassignmentEvent, error) {
+	defer s.since("GetReassignmentEvents", time.Now())
+	return s.next.GetReassignmentEvents(ctx, key)
+}
+
+func (s *InstrumentedStore) GetHighChurnPRs(ctx context.Context, minReassigns, limit, offset int) ([]ChurnPR, int, error) {
+	defer s.since("GetHighChurnPRs", time.Now())
+	return s.next.GetHighChurnPRs(ctx, minReassigns, limit, offset)
+}
+
+func (s *InstrumentedStore) GetTeamOpenAssignments(ctx context.Context, teamName string) ([]OpenAssignment, error) {
+	defer s.since("GetTeamOpenAssignments", time.Now())
+	return s.next.GetTeamOpenAssignments(ctx, teamName)
+}
+
+func (s *InstrumentedStore) SwapReviewer(ctx context.Context, key PRKey, oldUserID, newUserID string, reason AssignmentReason) (bool, error) {
+	defer s.since("SwapReviewer", time.Now())
+	return s.next.SwapReviewer(ctx, key, oldUserID, newUserID, reason)
+}
+
+func (s *InstrumentedStore) ReplaceUserSkills(ctx context.Context, userID string, skills []string) error {
+	defer s.since("ReplaceUserSkills", time.Now())
+	return s.next.ReplaceUserSkills(ctx, userID, skills)
+}
+
+func (s *InstrumentedStore) GetUsersWithSkills(ctx context.Context, userIDs, skills []string) (map[string]bool, error) {
+	defer s.since("GetUsersWithSkills", time.Now())
+	return s.next.GetUsersWithSkills(ctx, userIDs, skills)
+}
+
+func (s *InstrumentedStore) GetAssignmentTrend(ctx context.Context, teamName, bucket string, since time.Time) ([]TrendPoint, error) {
+	defer s.since("GetAssignmentTrend", time.Now())
+	return s.next.GetAssignmentTrend(ctx, teamName, bucket, since)
+}
+
+func (s *InstrumentedStore) GetOpenReviewCounts(ctx context.Context, teamName string) (map[string]int, error) {
+	defer s.since("GetOpenReviewCounts", time.Now())
+	return s.next.GetOpenReviewCounts(ctx, teamName)
+}
+
+func (s *InstrumentedStore) GetActiveTeamMembersWithOpenPRCount(ctx context.Context, teamName string) ([]MemberWithOpenPRs, error) {
+	defer s.since("GetActiveTeamMembersWithOpenPRCount", time.Now())
+	return s.next.GetActiveTeamMembersWithOpenPRCount(ctx, teamName)
+}
+
+func (s *InstrumentedStore) GetActiveMemberOpenReviews(ctx context.Context) ([]MemberOpenReviews, error) {
+	defer s.since("GetActiveMemberOpenReviews", time.Now())
+	return s.next.GetActiveMemberOpenReviews(ctx)
+}
+
+func (s *InstrumentedStore) GetOldestOpenPRs(ctx context.Context, limit int) ([]PullRequest, error) {
+	defer s.since("GetOldestOpenPRs", time.Now())
+	return s.next.GetOldestOpenPRs(ctx, limit)
+}
+
+func (s *InstrumentedStore) GetReviewLeaderboard(ctx context.Context, teamName string, since time.Time, limit, offset int) ([]LeaderboardEntry, int, error) {
+	defer s.since("GetReviewLeaderboard", time.Now())
+	return s.next.GetReviewLeaderboard(ctx, teamName, since, limit, offset)
+}
+
+func (s *InstrumentedStore) GetTeamsBySize(ctx context.Context, minMembers int, maxMembers *int, limit, offset int) ([]TeamSize, int, error) {
+	defer s.since("GetTeamsBySize", time.Now())
+	return s.next.GetTeamsBySize(ctx, minMembers, maxMembers, limit, offset)
+}
+
+func (s *InstrumentedStore) GetDeadlineCompliance(ctx context.Context, teamName string, since, now time.Time) (int, int, error) {
+	defer s.since("GetDeadlineCompliance", time.Now())
+	return s.next.GetDeadlineCompliance(ctx, teamName, since, now)
+}
+
+func (s *InstrumentedStore) GetCrossTeamReviewCounts(ctx context.Context, teamName string, since time.Time) ([]CrossTeamReviewer, error) {
+	defer s.since("GetCrossTeamReviewCounts", time.Now())
+	return s.next.GetCrossTeamReviewCounts(ctx, teamName, since)
+}
+
+func (s *InstrumentedStore) GetTeamReviewStats(ctx context.Context, teamName string) ([]MemberReviewStats, error) {
+	defer s.since("GetTeamReviewStats", time.Now())
+	return s.next.GetTeamReviewStats(ctx, teamName)
+}
+
+func (s *InstrumentedStore) GetStrategyOutcomes(ctx context.Context, since time.Time) ([]StrategyOutcome, error) {
+	defer s.since("GetStrategyOutcomes", time.Now())
+	return s.next.GetStrategyOutcomes(ctx, since)
+}
+
+func (s *InstrumentedStore) GetActiveUserAssignmentCounts(ctx context.Context, since time.Time) ([]UserAssignmentCount, error) {
+	defer s.since("GetActiveUserAssignmentCounts", time.Now())
+	return s.next.GetActiveUserAssignmentCounts(ctx, since)
+}
+
+func (s *InstrumentedStore) CreateWebhookSubscription(ctx context.Context, sub *WebhookSubscription) error {
+	defer s.since("CreateWebhookSubscription", time.Now())
+	return s.next.CreateWebhookSubscription(ctx, sub)
+}
+
+func (s *InstrumentedStore) GetWebhookSubscriptions(ctx context.Context) ([]WebhookSubscription, error) {
+	defer s.since("GetWebhookSubscriptions", time.Now())
+	return s.next.GetWebhookSubscriptions(ctx)
+}
+
+func (s *InstrumentedStore) GetWebhookSubscription(ctx context.Context, id int64) (*WebhookSubscription, error) {
+	defer s.since("GetWebhookSubscription", time.Now())
+	return s.next.GetWebhookSubscription(ctx, id)
+}
+
+func (s *InstrumentedStore) DeleteWebhookSubscription(ctx context.Context, id int64) (bool, error) {
+	defer s.since("DeleteWebhookSubscription", time.Now())
+	return s.next.DeleteWebhookSubscription(ctx, id)
+}
+
+func (s *InstrumentedStore) RecordPoolSnapshots(ctx context.Context, takenAt time.Time, busyThreshold int) (int64, error) {
+	defer s.since("RecordPoolSnapshots", time.Now())
+	return s.next.RecordPoolSnapshots(ctx, takenAt, busyThreshold)
+}
+
+func (s *InstrumentedStore) GetPoolTrend(ctx context.Context, teamName, bucket string, since time.Time) ([]PoolPoint, error) {
+	defer s.since("GetPoolTrend", time.Now())
+	return s.next.GetPoolTrend(ctx, teamName, bucket, since)
+}
+
+func (s *InstrumentedStore) GetReviewWeights(ctx context.Context, userIDs []string, now time.Time) (map[string]float64, error) {
+	defer s.since("GetReviewWeights", time.Now())
+	return s.next.GetReviewWeights(ctx, userIDs, now)
+}
+
+func (s *InstrumentedStore) SetUserBoost(ctx context.Context, userID string, factor float64, expiresAt time.Time) error {
+	defer s.since("SetUserBoost", time.Now())
+	return s.next.SetUserBoost(ctx, userID, factor, expiresAt)
+}
+
+func (s *InstrumentedStore) GetResponseTimes(ctx context.Context, userIDs []string, since time.Time) (map[string]ResponseTime, error) {
+	defer s.since("GetResponseTimes", time.Now())
+	return s.next.GetResponseTimes(ctx, userIDs, since)
+}
+
+func (s *InstrumentedStore) BackfillAuthors(ctx context.Context, fallbackTeam string, batchSize int) ([]PlaceholderUser, error) {
+	defer s.since("BackfillAuthors", time.Now())
+	return s.next.BackfillAuthors(ctx, fallbackTeam, batchSize)
+}
@@ -0,0 +1,187 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+type PRStatusFix struct {
+	TeamName         string            `json:"team_name"`
+	PullRequestID    string            `json:"pull_request_id"`
+	Status           PullRequestStatus `json:"status"`
+	PreviousMergedAt *time.Time        `json:"previous_merged_at"`
+	MergedAt         *time.Time        `json:"merged_at"`
+}
+
+func (s *PostgresStore) FixPRStatusInconsistencies(ctx context.Context, dryRun bool) ([]PRStatusFix, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fix PR status inconsistencies: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		SELECT team_name, pull_request_id, status, merged_at, COALESCE(updated_at, created_at)
+		FROM pull_requests
+		WHERE (status = $1 AND merged_at IS NULL) OR (status <> $1 AND merged_at IS NOT NULL)
+		ORDER BY pull_request_id, team_name
+		FOR UPDATE
+	`
+	rows, err := tx.QueryContext(ctx, query, PRStatusMerged)
+	if err != nil {
+		return nil, fmt.Errorf("fix PR status inconsistencies: %w", err)
+	}
+
+	var fixes []PRStatusFix
+	for rows.Next() {
+		var fix PRStatusFix
+		var mergedAt sql.NullTime
+		var lastChange time.Time
+		if err := rows.Scan(&fix.TeamName, &fix.PullRequestID, &fix.Status, &mergedAt, &lastChange); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("fix PR status inconsistencies: %w", err)
+		}
+		if mergedAt.Valid {
+			fix.PreviousMergedAt = &mergedAt.Time
+		}
+		if fix.Status == PRStatusMerged {
+			fix.MergedAt = &lastChange
+		}
+		fixes = append(fixes, fix)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("fix PR status inconsistencies: %w", err)
+	}
+
+	if dryRun {
+		return fixes, nil
+	}
+
+	update := `UPDATE pull_requests SET merged_at = $1, updated_at = NOW() WHERE team_name = $2 AND pull_request_id = $3`
+	for _, fix := range fixes {
+		if _, err := tx.ExecContext(ctx, update, fix.MergedAt, fix.TeamName, fix.PullRequestID); err != nil {
+			return nil, fmt.Errorf("fix PR status inconsistencies: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit fix PR status inconsistencies: %w", err)
+	}
+	return fixes, nil
+}
+
+type InactiveAssignment struct {
+	PullRequest       PullRequest
+	InactiveReviewers []string
+}
+
+func (s *PostgresStore) GetInactiveReviewerAssignments(ctx context.Context) ([]InactiveAssignment, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + prColumnsAliased + `, r.user_id
+		FROM pull_requests p
+		JOIN pr_reviewers r ON r.team_name = p.team_name AND r.pull_request_id = p.pull_request_id
+		JOIN users u ON u.user_id = r.user_id
+		WHERE p.status = $1 AND u.is_active = false
+		ORDER BY p.created_at, p.pull_request_id, p.team_name, r.user_id
+	`
+	rows, err := s.db.QueryContext(ctx, query, PRStatusOpen)
+	if err != nil {
+		return nil, fmt.Errorf("get inactive reviewer assignments: %w", err)
+	}
+	defer rows.Close()
+
+	var assignments []InactiveAssignment
+	for rows.Next() {
+		var userID string
+		pr, err := scanPR(withExtra(rows, &userID))
+		if err != nil {
+			return nil, fmt.Errorf("get inactive reviewer assignments: %w", err)
+		}
+		if n := len(assignments); n > 0 && assignments[n-1].PullRequest.Key() == pr.Key() {
+			assignments[n-1].InactiveReviewers = append(assignments[n-1].InactiveReviewers, userID)
+			continue
+		}
+		assignments = append(assignments, InactiveAssignment{
+			PullRequest:       *pr,
+			InactiveReviewers: []string{userID},
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get inactive reviewer assignments: %w", err)
+	}
+	return assignments, nil
+}
+
+type SelfReview struct {
+	PullRequestID string            `json:"pull_request_id"`
+	AuthorID      string            `json:"author_id"`
+	Status        PullRequestStatus `json:"status"`
+	CreatedAt     time.Time         `json:"created_at"`
+	AssignedAt    time.Time         `json:"assigned_at"`
+}
+
+func (s *PostgresStore) GetSelfReviews(ctx context.Context) ([]SelfReview, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT p.pull_request_id, p.author_id, p.status, p.created_at, r.assigned_at
+		FROM pull_requests p
+		JOIN pr_reviewers r ON r.team_name = p.team_name AND r.pull_request_id = p.pull_request_id AND r.user_id = p.author_id
+		ORDER BY r.assigned_at, p.pull_request_id
+	`
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("get self reviews: %w", err)
+	}
+	defer rows.Close()
+
+	return scanSelfReviews(rows)
+}
+
+func (s *PostgresStore) RemoveSelfReviews(ctx context.Context) ([]SelfReview, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		WITH removed AS (
+			DELETE FROM pr_reviewers r
+			USING pull_requests p
+			WHERE p.team_name = r.team_name AND p.pull_request_id = r.pull_request_id AND r.user_id = p.author_id
+			RETURNING p.pull_request_id, p.author_id, p.status, p.created_at, r.assigned_at
+		)
+		SELECT * FROM removed
+		ORDER BY assigned_at, pull_request_id
+	`
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("remove self reviews: %w", err)
+	}
+	defer rows.Close()
+
+	return scanSelfReviews(rows)
+}
+
+func scanSelfReviews(rows *sql.Rows) ([]SelfReview, error) {
+	var reviews []SelfReview
+	for rows.Next() {
+		var review SelfReview
+		if err := rows.Scan(&review.PullRequestID, &review.AuthorID, &review.Status, &review.CreatedAt, &review.AssignedAt); err != nil {
+			return nil, fmt.Errorf("scan self reviews: %w", err)
+		}
+		reviews = append(reviews, review)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("scan self reviews: %w", err)
+	}
+	return reviews, nil
+}
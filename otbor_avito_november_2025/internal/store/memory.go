@@ -0,0 +1,1919 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+type memoryTeam struct {
+	team               Team
+	defaultWeeklyQuota *int
+}
+
+type memoryUser struct {
+	user           User
+	reviewWeight   float64
+	boostFactor    float64
+	boostExpiresAt *time.Time
+	weeklyQuota    *int
+}
+
+type memoryPR struct {
+	pr        PullRequest
+	updatedAt *time.Time
+}
+
+type memoryReviewer struct {
+	pr             PRKey
+	userID         string
+	assignedAt     time.Time
+	acknowledgedAt *time.Time
+	reason         AssignmentReason
+	load           int
+}
+
+type memoryReassignment struct {
+	pr         PRKey
+	oldUserID  *string
+	newUserID  string
+	reason     string
+	reassigned time.Time
+}
+
+type memoryReviewKey struct {
+	pr     PRKey
+	userID string
+}
+
+type memoryAPIKey struct {
+	userID    string
+	createdAt time.Time
+	lastUsed  *time.Time
+	revokedAt *time.Time
+}
+
+type memoryPoolSnapshot struct {
+	teamName  string
+	takenAt   time.Time
+	active    int
+	available int
+}
+
+type MemoryStore struct {
+	mu sync.RWMutex
+
+	teams          map[string]*memoryTeam
+	users          map[string]*memoryUser
+	prs            map[PRKey]*memoryPR
+	reviewers      []*memoryReviewer
+	approvals      map[memoryReviewKey]time.Time
+	escalations    map[memoryReviewKey]time.Time
+	pending        map[PRKey]*PendingAssignment
+	reassignments  []memoryReassignment
+	blackouts      []BlackoutWindow
+	nextBlackoutID int64
+	pathOwners     map[string][]PathOwner
+	fallbacks      map[string][]string
+	skills         map[string]map[string]bool
+	apiKeys        map[string]*memoryAPIKey
+	flags          map[string]bool
+	poolSnapshots  []memoryPoolSnapshot
+	webhooks       []WebhookSubscription
+	nextWebhookID  int64
+
+	teamScopedPRIDs bool
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+const memoryDefaultRequiredReviewers = 2
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		teams:       make(map[string]*memoryTeam),
+		users:       make(map[string]*memoryUser),
+		prs:         make(map[PRKey]*memoryPR),
+		approvals:   make(map[memoryReviewKey]time.Time),
+		escalations: make(map[memoryReviewKey]time.Time),
+		pending:     make(map[PRKey]*PendingAssignment),
+		pathOwners:  make(map[string][]PathOwner),
+		fallbacks:   make(map[string][]string),
+		skills:      make(map[string]map[string]bool),
+		apiKeys:     make(map[string]*memoryAPIKey),
+		flags:       make(map[string]bool),
+	}
+}
+
+func (m *MemoryStore) CreateTeam(ctx context.Context, team *Team) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.createTeam(team)
+}
+
+func (m *MemoryStore) CreateTeamWithMembers(ctx context.Context, team *Team, members []User) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.createTeam(team); err != nil {
+		return err
+	}
+	for _, member := range members {
+		m.upsertUser(member)
+	}
+	return nil
+}
+
+func (m *MemoryStore) UpdateTeamWithMembers(ctx context.Context, team *Team, members []User) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if existing, ok := m.teams[team.Name]; ok && team.RequiredReviewers >= 1 {
+		existing.team.RequiredReviewers = team.RequiredReviewers
+	}
+	for _, member := range members {
+		m.upsertUser(member)
+	}
+	return nil
+}
+
+func (m *MemoryStore) GetTeam(ctx context.Context, name string) (*Team, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	team, ok := m.teams[name]
+	if !ok {
+		return nil, nil
+	}
+	result := team.team
+	return &result, nil
+}
+
+func (m *MemoryStore) GetTeamMembers(ctx context.Context, teamName string) ([]User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.teamUsers(teamName, false, nil), nil
+}
+
+func (m *MemoryStore) CreateOrUpdateUser(ctx context.Context, user *User) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.upsertUser(*user)
+	return nil
+}
+
+func (m *MemoryStore) GetUser(ctx context.Context, userID string) (*User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	user, ok := m.users[userID]
+	if !ok {
+		return nil, nil
+	}
+	result := user.user
+	return &result, nil
+}
+
+func (m *MemoryStore) UpdateUser(ctx context.Context, user *User) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if existing, ok := m.users[user.UserID]; ok {
+		existing.user.Username = user.Username
+		existing.user.IsActive = user.IsActive
+		existing.user.TeamName = user.TeamName
+	}
+	return nil
+}
+
+func (m *MemoryStore) GetActiveTeamMembers(ctx context.Context, teamName string, excludeUserID *string) ([]User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.teamUsers(teamName, true, excludeUserID), nil
+}
+
+func (m *MemoryStore) CreatePR(ctx context.Context, pr *PullRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.prExists(pr.Key()) {
+		return ErrDuplicateKey
+	}
+	stored := *pr
+	stored.CreatedAt = time.Now()
+	stored.MergedAt = nil
+	m.prs[pr.Key()] = &memoryPR{pr: stored}
+	return nil
+}
+
+// SetTeamScopedPRIDs chooses whether PR ids are unique per team or, by
+// default, across all teams.
+func (m *MemoryStore) SetTeamScopedPRIDs(ctx context.Context, enabled bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.teamScopedPRIDs = enabled
+	return nil
+}
+
+func (m *MemoryStore) CreatePRWithReviewers(ctx context.Context, pr *PullRequest, reviewers []ReviewerAssignment) (map[string]int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.prExists(pr.Key()) {
+		return nil, ErrDuplicateKey
+	}
+	seen := make(map[string]bool, len(reviewers))
+	for _, reviewer := range reviewers {
+		if seen[reviewer.UserID] {
+			return nil, ErrDuplicateKey
+		}
+		seen[reviewer.UserID] = true
+	}
+
+	stored := *pr
+	stored.CreatedAt = time.Now()
+	stored.MergedAt = nil
+	m.prs[pr.Key()] = &memoryPR{pr: stored}
+
+	loads := make(map[string]int, len(reviewers))
+	for _, reviewer := range reviewers {
+		loads[reviewer.UserID] = m.addReviewer(pr.Key(), reviewer.UserID, reviewer.Reason)
+		m.logReassignment(pr.Key(), nil, reviewer.UserID, reviewer.Reason.Reason)
+	}
+	return loads, nil
+}
+
+func (m *MemoryStore) GetPR(ctx context.Context, key PRKey) (*PullRequest, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if key.TeamName != "" {
+		pr, ok := m.prs[key]
+		if !ok {
+			return nil, nil
+		}
+		result := pr.pr
+		return &result, nil
+	}
+
+	var result *PullRequest
+	for _, pr := range m.prs {
+		if pr.pr.PullRequestID != key.PullRequestID {
+			continue
+		}
+		if result != nil {
+			return nil, ErrAmbiguousPR
+		}
+		found := pr.pr
+		result = &found
+	}
+	return result, nil
+}
+
+func (m *MemoryStore) UpdatePR(ctx context.Context, pr *PullRequest) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if existing, ok := m.prs[pr.Key()]; ok {
+		now := time.Now()
+		existing.pr.PullRequestName = pr.PullRequestName
+		existing.pr.Status = pr.Status
+		existing.pr.MergedAt = pr.MergedAt
+		existing.updatedAt = &now
+	}
+	return nil
+}
+
+func (m *MemoryStore) AssignReviewer(ctx context.Context, key PRKey, userID string, reason AssignmentReason) (int, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.reviewer(key, userID) != nil {
+		return 0, false, nil
+	}
+	load := m.addReviewer(key, userID, reason)
+	m.logReassignment(key, nil, userID, reason.Reason)
+	return load, true, nil
+}
+
+func (m *MemoryStore) GetPRReviewers(ctx context.Context, key PRKey) ([]User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var users []User
+	for _, r := range m.reviewers {
+		if r.pr != key {
+			continue
+		}
+		if user, ok := m.users[r.userID]; ok {
+			users = append(users, user.user)
+		}
+	}
+	return users, nil
+}
+
+func (m *MemoryStore) GetRecentAuthorReviewers(ctx context.Context, authorID string, limit int) ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var prs []PullRequest
+	for _, pr := range m.prs {
+		if pr.pr.AuthorID == authorID {
+			prs = append(prs, pr.pr)
+		}
+	}
+	sort.Slice(prs, func(i, j int) bool {
+		return prs[i].CreatedAt.After(prs[j].CreatedAt)
+	})
+	if len(prs) > limit {
+		prs = prs[:limit]
+	}
+
+	recent := make(map[PRKey]bool, len(prs))
+	for _, pr := range prs {
+		recent[pr.Key()] = true
+	}
+	seen := make(map[string]bool)
+	var userIDs []string
+	for _, r := range m.reviewers {
+		if recent[r.pr] && !seen[r.userID] {
+			seen[r.userID] = true
+			userIDs = append(userIDs, r.userID)
+		}
+	}
+	sort.Strings(userIDs)
+	return userIDs, nil
+}
+
+func (m *MemoryStore) RemoveReviewer(ctx context.Context, key PRKey, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.removeReviewers(func(r *memoryReviewer) bool {
+		return r.pr == key && r.userID == userID
+	})
+	return nil
+}
+
+func (m *MemoryStore) GetUserAssignedPRs(ctx context.Context, userID string, status *PullRequestStatus, limit, offset int) ([]PullRequest, int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var prs []PullRequest
+	for _, r := range m.reviewers {
+		if r.userID != userID {
+			continue
+		}
+		if pr, ok := m.prs[r.pr]; ok && (status == nil || pr.pr.Status == *status) {
+			prs = append(prs, pr.pr)
+		}
+	}
+	sort.Slice(prs, func(i, j int) bool {
+		if !prs[i].CreatedAt.Equal(prs[j].CreatedAt) {
+			return prs[i].CreatedAt.After(prs[j].CreatedAt)
+		}
+		return prs[i].PullRequestID < prs[j].PullRequestID
+	})
+
+	if limit == 0 {
+		limit = len(prs)
+	}
+	from, to := pageBounds(len(prs), limit, offset)
+	return prs[from:to], len(prs), nil
+}
+
+func (m *MemoryStore) AcknowledgeReview(ctx context.Context, key PRKey, userID string, now time.Time) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	r := m.reviewer(key, userID)
+	if r == nil {
+		return false, nil
+	}
+	if r.acknowledgedAt == nil {
+		r.acknowledgedAt = &now
+	}
+	return true, nil
+}
+
+func (m *MemoryStore) GetPRAcknowledgements(ctx context.Context, key PRKey) ([]ReviewerAcknowledgement, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var acks []ReviewerAcknowledgement
+	for _, r := range m.reviewers {
+		if r.pr == key {
+			acks = append(acks, ReviewerAcknowledgement{UserID: r.userID, AcknowledgedAt: r.acknowledgedAt})
+		}
+	}
+	sort.Slice(acks, func(i, j int) bool {
+		return acks[i].UserID < acks[j].UserID
+	})
+	return acks, nil
+}
+
+func (m *MemoryStore) ApprovePR(ctx context.Context, key PRKey, userID string, now time.Time) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.reviewer(key, userID) == nil {
+		return false, nil
+	}
+	m.approvals[memoryReviewKey{key, userID}] = now
+	return true, nil
+}
+
+func (m *MemoryStore) GetPRApprovals(ctx context.Context, key PRKey) ([]Approval, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.prApprovals(key), nil
+}
+
+func (m *MemoryStore) CountPRApprovals(ctx context.Context, key PRKey) (int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return len(m.prApprovals(key)), nil
+}
+
+func (m *MemoryStore) ReplaceUserAPIKey(ctx context.Context, userID, keyHash string, createdAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.apiKeys[keyHash]; ok {
+		return ErrDuplicateKey
+	}
+	m.revokeUserAPIKeys(userID, createdAt)
+	m.apiKeys[keyHash] = &memoryAPIKey{userID: userID, createdAt: createdAt}
+	return nil
+}
+
+func (m *MemoryStore) RevokeUserAPIKeys(ctx context.Context, userID string, revokedAt time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.revokeUserAPIKeys(userID, revokedAt), nil
+}
+
+func (m *MemoryStore) GetAPIKeyUser(ctx context.Context, keyHash string, usedAt time.Time) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key, ok := m.apiKeys[keyHash]
+	if !ok || key.revokedAt != nil {
+		return "", nil
+	}
+	key.lastUsed = &usedAt
+	return key.userID, nil
+}
+
+func (m *MemoryStore) GetUserAssignments(ctx context.Context, userID string, since, until time.Time, limit, offset int) ([]Assignment, int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var assignments []Assignment
+	for _, r := range m.reviewers {
+		if r.userID != userID || r.assignedAt.Before(since) || !r.assignedAt.Before(until) {
+			continue
+		}
+		if pr, ok := m.prs[r.pr]; ok {
+			assignments = append(assignments, Assignment{PullRequest: pr.pr, AssignedAt: r.assignedAt})
+		}
+	}
+	sort.Slice(assignments, func(i, j int) bool {
+		if !assignments[i].AssignedAt.Equal(assignments[j].AssignedAt) {
+			return assignments[i].AssignedAt.After(assignments[j].AssignedAt)
+		}
+		return assignments[i].PullRequest.PullRequestID < assignments[j].PullRequest.PullRequestID
+	})
+
+	from, to := pageBounds(len(assignments), limit, offset)
+	return assignments[from:to], len(assignments), nil
+}
+
+func (m *MemoryStore) GetPRAssignmentReasons(ctx context.Context, key PRKey) ([]ReviewerAssignment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var assignments []ReviewerAssignment
+	for _, r := range m.reviewers {
+		if r.pr != key {
+			continue
+		}
+		load := r.load
+		assignments = append(assignments, ReviewerAssignment{
+			UserID:           r.userID,
+			AssignedAt:       r.assignedAt,
+			Reason:           r.reason,
+			LoadAtAssignment: &load,
+		})
+	}
+	sort.Slice(assignments, func(i, j int) bool {
+		if !assignments[i].AssignedAt.Equal(assignments[j].AssignedAt) {
+			return assignments[i].AssignedAt.Before(assignments[j].AssignedAt)
+		}
+		return assignments[i].UserID < assignments[j].UserID
+	})
+	return assignments, nil
+}
+
+func (m *MemoryStore) GetUserReviewIntervals(ctx context.Context, userID string, since time.Time) ([]ReviewInterval, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var intervals []ReviewInterval
+	for _, r := range m.reviewers {
+		pr, ok := m.prs[r.pr]
+		if r.userID != userID || !ok {
+			continue
+		}
+		if pr.pr.MergedAt == nil || pr.pr.MergedAt.After(since) {
+			intervals = append(intervals, ReviewInterval{Start: r.assignedAt, End: pr.pr.MergedAt})
+		}
+	}
+	return intervals, nil
+}
+
+func (m *MemoryStore) GetBlackoutWindows(ctx context.Context, teamName string) ([]BlackoutWindow, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var windows []BlackoutWindow
+	for _, window := range m.blackouts {
+		if window.TeamName == teamName {
+			windows = append(windows, window)
+		}
+	}
+	sort.Slice(windows, func(i, j int) bool {
+		if !windows[i].StartsAt.Equal(windows[j].StartsAt) {
+			return windows[i].StartsAt.Before(windows[j].StartsAt)
+		}
+		return windows[i].ID < windows[j].ID
+	})
+	return windows, nil
+}
+
+func (m *MemoryStore) CreateBlackoutWindow(ctx context.Context, window *BlackoutWindow) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nextBlackoutID++
+	window.ID = m.nextBlackoutID
+	m.blackouts = append(m.blackouts, *window)
+	return nil
+}
+
+func (m *MemoryStore) GetUnderReviewedPRs(ctx context.Context, teamName string, required int) ([]ReviewerCount, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var counts []ReviewerCount
+	for _, pr := range m.sortedPRs() {
+		if pr.Status != PRStatusOpen || m.userTeam(pr.AuthorID) != teamName {
+			continue
+		}
+		if reviewers := m.reviewerCount(pr.Key()); reviewers < required {
+			counts = append(counts, ReviewerCount{PullRequest: pr, Reviewers: reviewers})
+		}
+	}
+	return counts, nil
+}
+
+func (m *MemoryStore) GetOverduePRs(ctx context.Context, now time.Time) ([]PullRequest, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	escalated := make(map[PRKey]bool, len(m.escalations))
+	for key := range m.escalations {
+		escalated[key.pr] = true
+	}
+
+	var prs []PullRequest
+	for _, pr := range m.prs {
+		if pr.pr.Status != PRStatusOpen || pr.pr.ReviewDeadline == nil || !pr.pr.ReviewDeadline.Before(now) || escalated[pr.pr.Key()] {
+			continue
+		}
+		if len(m.prApprovals(pr.pr.Key())) < m.prRequiredReviewers(pr.pr) {
+			prs = append(prs, pr.pr)
+		}
+	}
+	sort.Slice(prs, func(i, j int) bool {
+		return prs[i].ReviewDeadline.Before(*prs[j].ReviewDeadline)
+	})
+	return prs, nil
+}
+
+func (m *MemoryStore) RecordEscalation(ctx context.Context, key PRKey, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	escalation := memoryReviewKey{key, userID}
+	if _, ok := m.escalations[escalation]; ok {
+		return ErrDuplicateKey
+	}
+	m.escalations[escalation] = time.Now()
+	return nil
+}
+
+func (m *MemoryStore) StreamReviewExport(ctx context.Context, since, until time.Time, fn func(ReviewExportRow) error) error {
+	m.mu.RLock()
+	var rows []ReviewExportRow
+	for _, r := range m.reviewers {
+		pr, ok := m.prs[r.pr]
+		if !ok || r.assignedAt.Before(since) || !r.assignedAt.Before(until) {
+			continue
+		}
+		state := "PENDING"
+		if pr.pr.Status == PRStatusMerged {
+			state = "COMPLETED"
+		} else if r.acknowledgedAt != nil {
+			state = "ACKNOWLEDGED"
+		}
+		rows = append(rows, ReviewExportRow{
+			PullRequestID: r.pr.PullRequestID,
+			ReviewerID:    r.userID,
+			ReviewState:   state,
+			AssignedAt:    r.assignedAt,
+			ReviewedAt:    pr.pr.MergedAt,
+		})
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(rows, func(i, j int) bool {
+		if !rows[i].AssignedAt.Equal(rows[j].AssignedAt) {
+			return rows[i].AssignedAt.Before(rows[j].AssignedAt)
+		}
+		if rows[i].PullRequestID != rows[j].PullRequestID {
+			return rows[i].PullRequestID < rows[j].PullRequestID
+		}
+		return rows[i].ReviewerID < rows[j].ReviewerID
+	})
+	for _, row := range rows {
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MemoryStore) StreamReviewMatrix(ctx context.Context, fn func(ReviewMatrixRow) error) error {
+	m.mu.RLock()
+	rows := make([]ReviewMatrixRow, 0, len(m.users))
+	for _, user := range m.users {
+		counts := make(map[string]int)
+		for _, r := range m.reviewers {
+			if pr, ok := m.prs[r.pr]; ok && r.userID == user.user.UserID {
+				counts[pr.pr.AuthorID]++
+			}
+		}
+		authors := make([]ReviewMatrixCell, 0, len(counts))
+		for authorID, assignments := range counts {
+			authors = append(authors, ReviewMatrixCell{AuthorID: authorID, Assignments: assignments})
+		}
+		sort.Slice(authors, func(i, j int) bool {
+			return authors[i].AuthorID < authors[j].AuthorID
+		})
+		rows = append(rows, ReviewMatrixRow{
+			UserID:   user.user.UserID,
+			Username: user.user.Username,
+			TeamName: user.user.TeamName,
+			IsActive: user.user.IsActive,
+			Authors:  authors,
+		})
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].UserID < rows[j].UserID
+	})
+	for _, row := range rows {
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MemoryStore) GetFeatureFlags(ctx context.Context) (map[string]bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	flags := make(map[string]bool, len(m.flags))
+	for name, enabled := range m.flags {
+		flags[name] = enabled
+	}
+	return flags, nil
+}
+
+func (m *MemoryStore) FixPRStatusInconsistencies(ctx context.Context, dryRun bool) ([]PRStatusFix, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var fixes []PRStatusFix
+	for _, pr := range m.prs {
+		merged := pr.pr.Status == PRStatusMerged
+		if merged == (pr.pr.MergedAt != nil) {
+			continue
+		}
+		fix := PRStatusFix{
+			TeamName:         pr.pr.TeamName,
+			PullRequestID:    pr.pr.PullRequestID,
+			Status:           pr.pr.Status,
+			PreviousMergedAt: pr.pr.MergedAt,
+		}
+		if merged {
+			lastChange := pr.pr.CreatedAt
+			if pr.updatedAt != nil {
+				lastChange = *pr.updatedAt
+			}
+			fix.MergedAt = &lastChange
+		}
+		fixes = append(fixes, fix)
+	}
+	sort.Slice(fixes, func(i, j int) bool {
+		if fixes[i].PullRequestID != fixes[j].PullRequestID {
+			return fixes[i].PullRequestID < fixes[j].PullRequestID
+		}
+		return fixes[i].TeamName < fixes[j].TeamName
+	})
+
+	if dryRun {
+		return fixes, nil
+	}
+
+	now := time.Now()
+	for _, fix := range fixes {
+		pr := m.prs[PRKey{TeamName: fix.TeamName, PullRequestID: fix.PullRequestID}]
+		pr.pr.MergedAt = fix.MergedAt
+		pr.updatedAt = &now
+	}
+	return fixes, nil
+}
+
+func (m *MemoryStore) GetInactiveReviewerAssignments(ctx context.Context) ([]InactiveAssignment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var assignments []InactiveAssignment
+	for _, pr := range m.sortedPRs() {
+		if pr.Status != PRStatusOpen {
+			continue
+		}
+		var inactive []string
+		for _, r := range m.reviewers {
+			if user, ok := m.users[r.userID]; ok && r.pr == pr.Key() && !user.user.IsActive {
+				inactive = append(inactive, r.userID)
+			}
+		}
+		if len(inactive) > 0 {
+			sort.Strings(inactive)
+			assignments = append(assignments, InactiveAssignment{PullRequest: pr, InactiveReviewers: inactive})
+		}
+	}
+	return assignments, nil
+}
+
+func (m *MemoryStore) GetSelfReviews(ctx context.Context) ([]SelfReview, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.selfReviews(), nil
+}
+
+func (m *MemoryStore) RemoveSelfReviews(ctx context.Context) ([]SelfReview, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	reviews := m.selfReviews()
+	m.removeReviewers(func(r *memoryReviewer) bool {
+		pr, ok := m.prs[r.pr]
+		return ok && pr.pr.AuthorID == r.userID
+	})
+	return reviews, nil
+}
+
+func (m *MemoryStore) GetPathOwners(ctx context.Context, teamName string) ([]PathOwner, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	owners := append([]PathOwner(nil), m.pathOwners[teamName]...)
+	sort.Slice(owners, func(i, j int) bool {
+		if owners[i].Pattern != owners[j].Pattern {
+			return owners[i].Pattern < owners[j].Pattern
+		}
+		return owners[i].UserID < owners[j].UserID
+	})
+	return owners, nil
+}
+
+func (m *MemoryStore) ReplacePathOwners(ctx context.Context, teamName string, owners []PathOwner) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	seen := make(map[PathOwner]bool, len(owners))
+	var unique []PathOwner
+	for _, owner := range owners {
+		if !seen[owner] {
+			seen[owner] = true
+			unique = append(unique, owner)
+		}
+	}
+	m.pathOwners[teamName] = unique
+	return nil
+}
+
+func (m *MemoryStore) GetFallbackTeams(ctx context.Context, teamName string) ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	teams := append([]string(nil), m.fallbacks[teamName]...)
+	sort.Strings(teams)
+	return teams, nil
+}
+
+func (m *MemoryStore) ReplaceFallbackTeams(ctx context.Context, teamName string, fallbackTeams []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	seen := make(map[string]bool, len(fallbackTeams))
+	var unique []string
+	for _, fallback := range fallbackTeams {
+		if _, ok := m.teams[fallback]; !ok || fallback == teamName || seen[fallback] {
+			continue
+		}
+		seen[fallback] = true
+		unique = append(unique, fallback)
+	}
+	m.fallbacks[teamName] = unique
+	return nil
+}
+
+func (m *MemoryStore) EnqueuePendingAssignment(ctx context.Context, key PRKey, nextAttemptAt, expiresAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if p, ok := m.pending[key]; ok {
+		p.NextAttemptAt = nextAttemptAt
+		p.ExpiresAt = expiresAt
+		return nil
+	}
+	m.pending[key] = &PendingAssignment{
+		TeamName:      key.TeamName,
+		PullRequestID: key.PullRequestID,
+		NextAttemptAt: nextAttemptAt,
+		ExpiresAt:     expiresAt,
+		CreatedAt:     time.Now(),
+	}
+	return nil
+}
+
+func (m *MemoryStore) GetDuePendingAssignments(ctx context.Context, now time.Time) ([]PendingAssignment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var due []PendingAssignment
+	for _, p := range m.sortedPending() {
+		if !p.NextAttemptAt.After(now) {
+			due = append(due, p)
+		}
+	}
+	return due, nil
+}
+
+func (m *MemoryStore) GetPendingAssignments(ctx context.Context) ([]PendingAssignment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.sortedPending(), nil
+}
+
+func (m *MemoryStore) GetPendingAssignment(ctx context.Context, key PRKey) (*PendingAssignment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	p, ok := m.pending[key]
+	if !ok {
+		return nil, nil
+	}
+	result := *p
+	return &result, nil
+}
+
+func (m *MemoryStore) ReschedulePendingAssignment(ctx context.Context, key PRKey, nextAttemptAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if p, ok := m.pending[key]; ok {
+		p.Attempts++
+		p.NextAttemptAt = nextAttemptAt
+	}
+	return nil
+}
+
+func (m *MemoryStore) DeletePendingAssignment(ctx context.Context, key PRKey) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.pending, key)
+	return nil
+}
+
+func (m *MemoryStore) GetWeeklyQuotaUsage(ctx context.Context, userIDs []string, weekStart time.Time) (map[string]QuotaUsage, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	usage := make(map[string]QuotaUsage)
+	for _, userID := range userIDs {
+		user, ok := m.users[userID]
+		if !ok {
+			continue
+		}
+		quota := user.weeklyQuota
+		if team, ok := m.teams[user.user.TeamName]; ok && quota == nil {
+			quota = team.defaultWeeklyQuota
+		}
+		if quota == nil {
+			continue
+		}
+		assigned := 0
+		for _, r := range m.reviewers {
+			if r.userID == userID && !r.assignedAt.Before(weekStart) {
+				assigned++
+			}
+		}
+		usage[userID] = QuotaUsage{Quota: *quota, Assigned: assigned}
+	}
+	return usage, nil
+}
+
+func (m *MemoryStore) SetUserWeeklyQuota(ctx context.Context, userID string, quota *int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if user, ok := m.users[userID]; ok {
+		user.weeklyQuota = copyInt(quota)
+	}
+	return nil
+}
+
+func (m *MemoryStore) SetTeamDefaultWeeklyQuota(ctx context.Context, teamName string, quota *int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if team, ok := m.teams[teamName]; ok {
+		team.defaultWeeklyQuota = copyInt(quota)
+	}
+	return nil
+}
+
+func (m *MemoryStore) ReplaceReviewer(ctx context.Context, key PRKey, oldUserID, newUserID string, reason AssignmentReason) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.reviewer(key, newUserID) != nil {
+		return false, nil
+	}
+	m.removeReviewers(func(r *memoryReviewer) bool {
+		return r.pr == key && r.userID == oldUserID
+	})
+	m.addReviewer(key, newUserID, reason)
+	m.logReassignment(key, &oldUserID, newUserID, reason.Reason)
+	return true, nil
+}
+
+func (m *MemoryStore) GetReassignmentEvents(ctx context.Context, key PRKey) ([]ReassignmentEvent, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var events []ReassignmentEvent
+	for _, r := range m.reassignments {
+		if r.pr == key {
+			events = append(events, ReassignmentEvent{
+				OldUserID:  r.oldUserID,
+				NewUserID:  r.newUserID,
+				Reason:     r.reason,
+				OccurredAt: r.reassigned,
+			})
+		}
+	}
+	return events, nil
+}
+
+func (m *MemoryStore) GetHighChurnPRs(ctx context.Context, minReassigns, limit, offset int) ([]ChurnPR, int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	counts := make(map[PRKey]int)
+	for _, r := range m.reassignments {
+		if r.oldUserID != nil {
+			counts[r.pr]++
+		}
+	}
+
+	var prs []ChurnPR
+	for key, count := range counts {
+		pr, ok := m.prs[key]
+		if !ok || count < minReassigns {
+			continue
+		}
+		reviewers := []string{}
+		for _, r := range m.reviewers {
+			if r.pr == key {
+				reviewers = append(reviewers, r.userID)
+			}
+		}
+		sort.Strings(reviewers)
+		prs = append(prs, ChurnPR{PullRequest: pr.pr, Reassignments: count, Reviewers: reviewers})
+	}
+	sort.Slice(prs, func(i, j int) bool {
+		if prs[i].Reassignments != prs[j].Reassignments {
+			return prs[i].Reassignments > prs[j].Reassignments
+		}
+		if prs[i].PullRequest.PullRequestID != prs[j].PullRequest.PullRequestID {
+			return prs[i].PullRequest.PullRequestID < prs[j].PullRequest.PullRequestID
+		}
+		return prs[i].PullRequest.TeamName < prs[j].PullRequest.TeamName
+	})
+
+	from, to := pageBounds(len(prs), limit, offset)
+	return prs[from:to], len(prs), nil
+}
+
+func (m *MemoryStore) GetTeamOpenAssignments(ctx context.Context, teamName string) ([]OpenAssignment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var assignments []OpenAssignment
+	for _, pr := range m.sortedPRs() {
+		if pr.Status != PRStatusOpen {
+			continue
+		}
+		var prAssignments []OpenAssignment
+		for _, r := range m.reviewers {
+			if r.pr == pr.Key() && m.userTeam(r.userID) == teamName {
+				prAssignments = append(prAssignments, OpenAssignment{
+					TeamName:      pr.TeamName,
+					PullRequestID: pr.PullRequestID,
+					AuthorID:      pr.AuthorID,
+					UserID:        r.userID,
+					Acknowledged:  r.acknowledgedAt != nil,
+					Approved:      m.approved(r),
+				})
+			}
+		}
+		sort.Slice(prAssignments, func(i, j int) bool {
+			return prAssignments[i].UserID < prAssignments[j].UserID
+		})
+		assignments = append(assignments, prAssignments...)
+	}
+	return assignments, nil
+}
+
+func (m *MemoryStore) SwapReviewer(ctx context.Context, key PRKey, oldUserID, newUserID string, reason AssignmentReason) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pr, ok := m.prs[key]
+	if !ok || pr.pr.Status != PRStatusOpen {
+		return false, nil
+	}
+	old := m.reviewer(key, oldUserID)
+	if old == nil || old.acknowledgedAt != nil || m.approved(old) || m.reviewer(key, newUserID) != nil {
+		return false, nil
+	}
+
+	m.removeReviewers(func(r *memoryReviewer) bool {
+		return r == old
+	})
+	m.addReviewer(key, newUserID, reason)
+	m.logReassignment(key, &oldUserID, newUserID, reason.Reason)
+	return true, nil
+}
+
+func (m *MemoryStore) ReplaceUserSkills(ctx context.Context, userID string, skills []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	set := make(map[string]bool, len(skills))
+	for _, skill := range skills {
+		set[skill] = true
+	}
+	m.skills[userID] = set
+	return nil
+}
+
+func (m *MemoryStore) GetUsersWithSkills(ctx context.Context, userIDs, skills []string) (map[string]bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	skilled := make(map[string]bool)
+	for _, userID := range userIDs {
+		has := m.skills[userID]
+		matched := 0
+		for _, skill := range skills {
+			if has[skill] {
+				matched++
+			}
+		}
+		if matched == len(skills) && matched > 0 {
+			skilled[userID] = true
+		}
+	}
+	return skilled, nil
+}
+
+func (m *MemoryStore) GetAssignmentTrend(ctx context.Context, teamName, bucket string, since time.Time) ([]TrendPoint, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	counts := make(map[time.Time]int)
+	for _, r := range m.reviewers {
+		if m.userTeam(r.userID) == teamName && !r.assignedAt.Before(since) {
+			counts[truncateTime(r.assignedAt, bucket)]++
+		}
+	}
+
+	points := make([]TrendPoint, 0, len(counts))
+	for start, count := range counts {
+		points = append(points, TrendPoint{BucketStart: start, Assignments: count})
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].BucketStart.Before(points[j].BucketStart)
+	})
+	return points, nil
+}
+
+func (m *MemoryStore) GetOpenReviewCounts(ctx context.Context, teamName string) (map[string]int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	counts := make(map[string]int)
+	for _, r := range m.reviewers {
+		if pr, ok := m.prs[r.pr]; ok && pr.pr.Status == PRStatusOpen && m.userTeam(r.userID) == teamName {
+			counts[r.userID]++
+		}
+	}
+	return counts, nil
+}
+
+func (m *MemoryStore) GetActiveTeamMembersWithOpenPRCount(ctx context.Context, teamName string) ([]MemberWithOpenPRs, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	users := m.teamUsers(teamName, true, nil)
+	members := make([]MemberWithOpenPRs, len(users))
+	for i, user := range users {
+		members[i] = MemberWithOpenPRs{User: user, OpenPRs: m.openReviews(user.UserID)}
+	}
+	return members, nil
+}
+
+func (m *MemoryStore) GetActiveMemberOpenReviews(ctx context.Context) ([]MemberOpenReviews, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var loads []MemberOpenReviews
+	for _, user := range m.users {
+		if user.user.IsActive {
+			loads = append(loads, MemberOpenReviews{
+				TeamName:    user.user.TeamName,
+				UserID:      user.user.UserID,
+				Username:    user.user.Username,
+				OpenReviews: m.openReviews(user.user.UserID),
+			})
+		}
+	}
+	sort.Slice(loads, func(i, j int) bool {
+		if loads[i].TeamName != loads[j].TeamName {
+			return loads[i].TeamName < loads[j].TeamName
+		}
+		return loads[i].UserID < loads[j].UserID
+	})
+	return loads, nil
+}
+
+func (m *MemoryStore) GetOldestOpenPRs(ctx context.Context, limit int) ([]PullRequest, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var prs []PullRequest
+	for _, pr := range m.sortedPRs() {
+		if pr.Status == PRStatusOpen && !m.reviewStarted(pr.Key()) {
+			prs = append(prs, pr)
+		}
+	}
+	_, to := pageBounds(len(prs), limit, 0)
+	return prs[:to], nil
+}
+
+func (m *MemoryStore) GetReviewLeaderboard(ctx context.Context, teamName string, since time.Time, limit, offset int) ([]LeaderboardEntry, int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	members := m.teamUsers(teamName, false, nil)
+	entries := make([]LeaderboardEntry, len(members))
+	for i, member := range members {
+		reviews := 0
+		for _, r := range m.reviewers {
+			pr, ok := m.prs[r.pr]
+			if ok && r.userID == member.UserID && pr.pr.Status == PRStatusMerged && pr.pr.MergedAt != nil && !pr.pr.MergedAt.Before(since) {
+				reviews++
+			}
+		}
+		entries[i] = LeaderboardEntry{UserID: member.UserID, Username: member.Username, Reviews: reviews}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Reviews != entries[j].Reviews {
+			return entries[i].Reviews > entries[j].Reviews
+		}
+		if entries[i].Username != entries[j].Username {
+			return entries[i].Username < entries[j].Username
+		}
+		return entries[i].UserID < entries[j].UserID
+	})
+	for i := range entries {
+		entries[i].Rank = i + 1
+	}
+
+	from, to := pageBounds(len(entries), limit, offset)
+	return entries[from:to], len(members), nil
+}
+
+func (m *MemoryStore) GetTeamsBySize(ctx context.Context, minMembers int, maxMembers *int, limit, offset int) ([]TeamSize, int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var teams []TeamSize
+	for name := range m.teams {
+		members := len(m.teamUsers(name, false, nil))
+		if members >= minMembers && (maxMembers == nil || members <= *maxMembers) {
+			teams = append(teams, TeamSize{TeamName: name, Members: members})
+		}
+	}
+	sort.Slice(teams, func(i, j int) bool {
+		if teams[i].Members != teams[j].Members {
+			return teams[i].Members < teams[j].Members
+		}
+		return teams[i].TeamName < teams[j].TeamName
+	})
+
+	from, to := pageBounds(len(teams), limit, offset)
+	return teams[from:to], len(teams), nil
+}
+
+func (m *MemoryStore) GetDeadlineCompliance(ctx context.Context, teamName string, since, now time.Time) (int, int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var compliant, total int
+	for _, pr := range m.prs {
+		deadline := pr.pr.ReviewDeadline
+		if deadline == nil || pr.pr.CreatedAt.Before(since) || m.userTeam(pr.pr.AuthorID) != teamName {
+			continue
+		}
+		var reviewedAt *time.Time
+		if approvals := m.prApprovals(pr.pr.Key()); len(approvals) >= m.prRequiredReviewers(pr.pr) {
+			reviewedAt = &approvals[m.prRequiredReviewers(pr.pr)-1].ApprovedAt
+		}
+		if reviewedAt == nil && pr.pr.MergedAt == nil && !deadline.Before(now) {
+			continue
+		}
+		total++
+		if reviewedAt != nil && !reviewedAt.After(*deadline) {
+			compliant++
+		}
+	}
+	return compliant, total, nil
+}
+
+func (m *MemoryStore) GetCrossTeamReviewCounts(ctx context.Context, teamName string, since time.Time) ([]CrossTeamReviewer, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	members := m.teamUsers(teamName, false, nil)
+	reviewers := make([]CrossTeamReviewer, len(members))
+	for i, member := range members {
+		reviews := 0
+		for _, r := range m.reviewers {
+			pr, ok := m.prs[r.pr]
+			if !ok || r.userID != member.UserID || r.assignedAt.Before(since) {
+				continue
+			}
+			if authorTeam, ok := m.users[pr.pr.AuthorID]; ok && authorTeam.user.TeamName != member.TeamName {
+				reviews++
+			}
+		}
+		reviewers[i] = CrossTeamReviewer{UserID: member.UserID, Username: member.Username, Reviews: reviews}
+	}
+	sort.Slice(reviewers, func(i, j int) bool {
+		if reviewers[i].Reviews != reviewers[j].Reviews {
+			return reviewers[i].Reviews > reviewers[j].Reviews
+		}
+		return reviewers[i].UserID < reviewers[j].UserID
+	})
+	return reviewers, nil
+}
+
+func (m *MemoryStore) GetTeamReviewStats(ctx context.Context, teamName string) ([]MemberReviewStats, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	members := m.teamUsers(teamName, true, nil)
+	stats := make([]MemberReviewStats, len(members))
+	for i, member := range members {
+		stats[i] = MemberReviewStats{UserID: member.UserID, Username: member.Username}
+		for _, r := range m.reviewers {
+			pr, ok := m.prs[r.pr]
+			if !ok || r.userID != member.UserID {
+				continue
+			}
+			switch pr.pr.Status {
+			case PRStatusOpen:
+				stats[i].OpenReviews++
+			case PRStatusMerged:
+				stats[i].MergedReviews++
+			}
+		}
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].UserID < stats[j].UserID
+	})
+	return stats, nil
+}
+
+func (m *MemoryStore) GetStrategyOutcomes(ctx context.Context, since time.Time) ([]StrategyOutcome, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	type strategyStats struct {
+		loads        []int
+		firstReviews map[PRKey]*time.Time
+	}
+
+	stats := make(map[string]*strategyStats)
+	for _, r := range m.reviewers {
+		if r.reason.Strategy == "" || r.assignedAt.Before(since) {
+			continue
+		}
+		st, ok := stats[r.reason.Strategy]
+		if !ok {
+			st = &strategyStats{firstReviews: make(map[PRKey]*time.Time)}
+			stats[r.reason.Strategy] = st
+		}
+		st.loads = append(st.loads, r.load)
+		first, seen := st.firstReviews[r.pr]
+		if !seen || (r.acknowledgedAt != nil && (first == nil || r.acknowledgedAt.Before(*first))) {
+			st.firstReviews[r.pr] = r.acknowledgedAt
+		}
+	}
+
+	outcomes := make([]StrategyOutcome, 0, len(stats))
+	for strategy, st := range stats {
+		outcome := StrategyOutcome{
+			Strategy:     strategy,
+			Assignments:  len(st.loads),
+			PullRequests: len(st.firstReviews),
+		}
+
+		var sum float64
+		for _, load := range st.loads {
+			sum += float64(load)
+		}
+		mean := sum / float64(len(st.loads))
+		var variance float64
+		for _, load := range st.loads {
+			variance += (float64(load) - mean) * (float64(load) - mean)
+		}
+		variance /= float64(len(st.loads))
+		outcome.LoadVariance = &variance
+
+		var total float64
+		reviewed := 0
+		for key, first := range st.firstReviews {
+			if pr, ok := m.prs[key]; ok && first != nil {
+				total += first.Sub(pr.pr.CreatedAt).Seconds()
+				reviewed++
+			}
+		}
+		if reviewed > 0 {
+			avg := total / float64(reviewed)
+			outcome.AvgFirstReviewSeconds = &avg
+		}
+		outcomes = append(outcomes, outcome)
+	}
+	sort.Slice(outcomes, func(i, j int) bool {
+		return outcomes[i].Strategy < outcomes[j].Strategy
+	})
+	return outcomes, nil
+}
+
+func (m *MemoryStore) GetActiveUserAssignmentCounts(ctx context.Context, since time.Time) ([]UserAssignmentCount, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var counts []UserAssignmentCount
+	for _, user := range m.users {
+		if !user.user.IsActive {
+			continue
+		}
+		assignments := 0
+		for _, r := range m.reviewers {
+			if r.userID == user.user.UserID && !r.assignedAt.Before(since) {
+				assignments++
+			}
+		}
+		counts = append(counts, UserAssignmentCount{
+			TeamName:    user.user.TeamName,
+			UserID:      user.user.UserID,
+			Username:    user.user.Username,
+			Assignments: assignments,
+		})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Assignments != counts[j].Assignments {
+			return counts[i].Assignments > counts[j].Assignments
+		}
+		return counts[i].UserID < counts[j].UserID
+	})
+	return counts, nil
+}
+
+func (m *MemoryStore) CreateWebhookSubscription(ctx context.Context, sub *WebhookSubscription) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nextWebhookID++
+	sub.ID = m.nextWebhookID
+	stored := *sub
+	stored.Events = append([]string(nil), sub.Events...)
+	m.webhooks = append(m.webhooks, stored)
+	return nil
+}
+
+func (m *MemoryStore) GetWebhookSubscriptions(ctx context.Context) ([]WebhookSubscription, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	subs := make([]WebhookSubscription, len(m.webhooks))
+	for i, sub := range m.webhooks {
+		subs[i] = sub
+		subs[i].Events = append([]string(nil), sub.Events...)
+	}
+	return subs, nil
+}
+
+func (m *MemoryStore) GetWebhookSubscription(ctx context.Context, id int64) (*WebhookSubscription, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, sub := range m.webhooks {
+		if sub.ID == id {
+			sub.Events = append([]string(nil), sub.Events...)
+			return &sub, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MemoryStore) DeleteWebhookSubscription(ctx context.Context, id int64) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, sub := range m.webhooks {
+		if sub.ID == id {
+			m.webhooks = append(m.webhooks[:i], m.webhooks[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *MemoryStore) RecordPoolSnapshots(ctx context.Context, takenAt time.Time, busyThreshold int) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var recorded int64
+	for name := range m.teams {
+		duplicate := false
+		for _, snapshot := range m.poolSnapshots {
+			if snapshot.teamName == name && snapshot.takenAt.Equal(takenAt) {
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			continue
+		}
+
+		snapshot := memoryPoolSnapshot{teamName: name, takenAt: takenAt}
+		for _, member := range m.teamUsers(name, true, nil) {
+			snapshot.active++
+			if m.openReviews(member.UserID) < busyThreshold {
+				snapshot.available++
+			}
+		}
+		m.poolSnapshots = append(m.poolSnapshots, snapshot)
+		recorded++
+	}
+	return recorded, nil
+}
+
+func (m *MemoryStore) GetPoolTrend(ctx context.Context, teamName, bucket string, since time.Time) ([]PoolPoint, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	latest := make(map[time.Time]memoryPoolSnapshot)
+	for _, snapshot := range m.poolSnapshots {
+		if snapshot.teamName != teamName || snapshot.takenAt.Before(since) {
+			continue
+		}
+		start := truncateTime(snapshot.takenAt, bucket)
+		if current, ok := latest[start]; !ok || snapshot.takenAt.After(current.takenAt) {
+			latest[start] = snapshot
+		}
+	}
+
+	points := make([]PoolPoint, 0, len(latest))
+	for start, snapshot := range latest {
+		points = append(points, PoolPoint{BucketStart: start, ActiveMembers: snapshot.active, AvailableMembers: snapshot.available})
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].BucketStart.Before(points[j].BucketStart)
+	})
+	return points, nil
+}
+
+func (m *MemoryStore) GetReviewWeights(ctx context.Context, userIDs []string, now time.Time) (map[string]float64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	weights := make(map[string]float64, len(userIDs))
+	for _, userID := range userIDs {
+		user, ok := m.users[userID]
+		if !ok {
+			continue
+		}
+		weight := user.reviewWeight
+		if user.boostExpiresAt != nil && user.boostExpiresAt.After(now) {
+			weight *= user.boostFactor
+		}
+		weights[userID] = weight
+	}
+	return weights, nil
+}
+
+func (m *MemoryStore) SetUserBoost(ctx context.Context, userID string, factor float64, expiresAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if user, ok := m.users[userID]; ok {
+		user.boostFactor = factor
+		user.boostExpiresAt = &expiresAt
+	}
+	return nil
+}
+
+func (m *MemoryStore) GetResponseTimes(ctx context.Context, userIDs []string, since time.Time) (map[string]ResponseTime, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	wanted := make(map[string]bool, len(userIDs))
+	for _, userID := range userIDs {
+		wanted[userID] = true
+	}
+
+	totals := make(map[string]float64)
+	times := make(map[string]ResponseTime, len(userIDs))
+	for _, r := range m.reviewers {
+		pr, ok := m.prs[r.pr]
+		if !ok || !wanted[r.userID] || r.assignedAt.Before(since) {
+			continue
+		}
+		respondedAt := r.acknowledgedAt
+		if respondedAt == nil {
+			respondedAt = pr.pr.MergedAt
+		}
+		if respondedAt == nil {
+			continue
+		}
+		totals[r.userID] += respondedAt.Sub(r.assignedAt).Seconds()
+		rt := times[r.userID]
+		rt.Samples++
+		times[r.userID] = rt
+	}
+	for userID, rt := range times {
+		rt.AvgSeconds = totals[userID] / float64(rt.Samples)
+		times[userID] = rt
+	}
+	return times, nil
+}
+
+func (m *MemoryStore) BackfillAuthors(ctx context.Context, fallbackTeam string, batchSize int) ([]PlaceholderUser, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	missing := make(map[string]*PlaceholderUser)
+	for _, pr := range m.prs {
+		authorID := pr.pr.AuthorID
+		if _, ok := m.users[authorID]; ok {
+			continue
+		}
+		user, ok := missing[authorID]
+		if !ok {
+			user = &PlaceholderUser{UserID: authorID, TeamName: fallbackTeam}
+			missing[authorID] = user
+		}
+		if pr.pr.TeamName != "" && (user.TeamName == fallbackTeam || pr.pr.TeamName < user.TeamName) {
+			user.TeamName = pr.pr.TeamName
+		}
+		user.PullRequests++
+	}
+
+	created := make([]PlaceholderUser, 0, len(missing))
+	for _, user := range missing {
+		if _, ok := m.teams[user.TeamName]; !ok {
+			m.createTeam(&Team{Name: user.TeamName})
+		}
+		m.upsertUser(User{UserID: user.UserID, Username: user.UserID, TeamName: user.TeamName})
+		created = append(created, *user)
+	}
+	sort.Slice(created, func(i, j int) bool {
+		return created[i].UserID < created[j].UserID
+	})
+	return created, nil
+}
+
+func (m *MemoryStore) createTeam(team *Team) error {
+	if _, ok := m.teams[team.Name]; ok {
+		return ErrDuplicateKey
+	}
+	required := team.RequiredReviewers
+	if required < 1 {
+		required = memoryDefaultRequiredReviewers
+	}
+	m.teams[team.Name] = &memoryTeam{team: Team{Name: team.Name, CreatedAt: time.Now(), RequiredReviewers: required}}
+	return nil
+}
+
+func (m *MemoryStore) upsertUser(user User) {
+	if existing, ok := m.users[user.UserID]; ok {
+		existing.user.Username = user.Username
+		existing.user.IsActive = user.IsActive
+		existing.user.TeamName = user.TeamName
+		return
+	}
+	user.CreatedAt = time.Now()
+	m.users[user.UserID] = &memoryUser{user: user, reviewWeight: 1}
+}
+
+func (m *MemoryStore) teamUsers(teamName string, activeOnly bool, excludeUserID *string) []User {
+	var users []User
+	for _, user := range m.users {
+		if user.user.TeamName != teamName || (activeOnly && !user.user.IsActive) {
+			continue
+		}
+		if excludeUserID != nil && user.user.UserID == *excludeUserID {
+			continue
+		}
+		users = append(users, user.user)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].UserID < users[j].UserID
+	})
+	return users
+}
+
+func (m *MemoryStore) userTeam(userID string) string {
+	if user, ok := m.users[userID]; ok {
+		return user.user.TeamName
+	}
+	return ""
+}
+
+func (m *MemoryStore) sortedPRs() []PullRequest {
+	prs := make([]PullRequest, 0, len(m.prs))
+	for _, pr := range m.prs {
+		prs = append(prs, pr.pr)
+	}
+	sort.Slice(prs, func(i, j int) bool {
+		if !prs[i].CreatedAt.Equal(prs[j].CreatedAt) {
+			return prs[i].CreatedAt.Before(prs[j].CreatedAt)
+		}
+		return prs[i].PullRequestID < prs[j].PullRequestID
+	})
+	return prs
+}
+
+func (m *MemoryStore) sortedPending() []PendingAssignment {
+	pending := make([]PendingAssignment, 0, len(m.pending))
+	for _, p := range m.pending {
+		pending = append(pending, *p)
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		if !pending[i].NextAttemptAt.Equal(pending[j].NextAttemptAt) {
+			return pending[i].NextAttemptAt.Before(pending[j].NextAttemptAt)
+		}
+		if pending[i].TeamName != pending[j].TeamName {
+			return pending[i].TeamName < pending[j].TeamName
+		}
+		return pending[i].PullRequestID < pending[j].PullRequestID
+	})
+	return pending
+}
+
+func (m *MemoryStore) reviewer(key PRKey, userID string) *memoryReviewer {
+	for _, r := range m.reviewers {
+		if r.pr == key && r.userID == userID {
+			return r
+		}
+	}
+	return nil
+}
+
+func (m *MemoryStore) prRequiredReviewers(pr PullRequest) int {
+	teamName := pr.TeamName
+	if teamName == "" {
+		if author, ok := m.users[pr.AuthorID]; ok {
+			teamName = author.user.TeamName
+		}
+	}
+	if team, ok := m.teams[teamName]; ok {
+		return team.team.RequiredReviewers
+	}
+	return memoryDefaultRequiredReviewers
+}
+
+func (m *MemoryStore) approved(r *memoryReviewer) bool {
+	approvedAt, ok := m.approvals[memoryReviewKey{r.pr, r.userID}]
+	return ok && !approvedAt.Before(r.assignedAt)
+}
+
+// prExists reports whether creating a PR with key would collide with a stored
+// one: the same key, or the same id in any team unless ids are team-scoped.
+func (m *MemoryStore) prExists(key PRKey) bool {
+	if _, ok := m.prs[key]; ok {
+		return true
+	}
+	if m.teamScopedPRIDs {
+		return false
+	}
+	for existing := range m.prs {
+		if existing.PullRequestID == key.PullRequestID {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *MemoryStore) reviewStarted(key PRKey) bool {
+	for _, r := range m.reviewers {
+		if r.pr == key && (r.acknowledgedAt != nil || m.approved(r)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *MemoryStore) prApprovals(key PRKey) []Approval {
+	var approvals []Approval
+	for _, r := range m.reviewers {
+		if r.pr != key {
+			continue
+		}
+		approvedAt, ok := m.approvals[memoryReviewKey{key, r.userID}]
+		if ok && !approvedAt.Before(r.assignedAt) {
+			approvals = append(approvals, Approval{UserID: r.userID, ApprovedAt: approvedAt})
+		}
+	}
+	sort.Slice(approvals, func(i, j int) bool {
+		if !approvals[i].ApprovedAt.Equal(approvals[j].ApprovedAt) {
+			return approvals[i].ApprovedAt.Before(approvals[j].ApprovedAt)
+		}
+		return approvals[i].UserID < approvals[j].UserID
+	})
+	return approvals
+}
+
+func (m *MemoryStore) reviewerCount(key PRKey) int {
+	count := 0
+	for _, r := range m.reviewers {
+		if r.pr == key {
+			count++
+		}
+	}
+	return count
+}
+
+func (m *MemoryStore) openReviews(userID string) int {
+	count := 0
+	for _, r := range m.reviewers {
+		if pr, ok := m.prs[r.pr]; ok && r.userID == userID && pr.pr.Status == PRStatusOpen {
+			count++
+		}
+	}
+	return count
+}
+
+func (m *MemoryStore) addReviewer(key PRKey, userID string, reason AssignmentReason) int {
+	load := m.openReviews(userID)
+	m.reviewers = append(m.reviewers, &memoryReviewer{
+		pr:         key,
+		userID:     userID,
+		assignedAt: time.Now(),
+		reason:     reason,
+		load:       load,
+	})
+	return load
+}
+
+func (m *MemoryStore) removeReviewers(match func(*memoryReviewer) bool) {
+	kept := m.reviewers[:0]
+	for _, r := range m.reviewers {
+		if !match(r) {
+			kept = append(kept, r)
+		}
+	}
+	m.reviewers = kept
+}
+
+func (m *MemoryStore) selfReviews() []SelfReview {
+	var reviews []SelfReview
+	for _, r := range m.reviewers {
+		pr, ok := m.prs[r.pr]
+		if !ok || pr.pr.AuthorID != r.userID {
+			continue
+		}
+		reviews = append(reviews, SelfReview{
+			PullRequestID: pr.pr.PullRequestID,
+			AuthorID:      pr.pr.AuthorID,
+			Status:        pr.pr.Status,
+			CreatedAt:     pr.pr.CreatedAt,
+			AssignedAt:    r.assignedAt,
+		})
+	}
+	sort.Slice(reviews, func(i, j int) bool {
+		if !reviews[i].AssignedAt.Equal(reviews[j].AssignedAt) {
+			return reviews[i].AssignedAt.Before(reviews[j].AssignedAt)
+		}
+		return reviews[i].PullRequestID < reviews[j].PullRequestID
+	})
+	return reviews
+}
+
+func (m *MemoryStore) revokeUserAPIKeys(userID string, revokedAt time.Time) int64 {
+	var revoked int64
+	for _, key := range m.apiKeys {
+		if key.userID == userID && key.revokedAt == nil {
+			at := revokedAt
+			key.revokedAt = &at
+			revoked++
+		}
+	}
+	return revoked
+}
+
+func (m *MemoryStore) logReassignment(key PRKey, oldUserID *string, newUserID, reason string) {
+	m.reassignments = append(m.reassignments, memoryReassignment{
+		pr:         key,
+		oldUserID:  oldUserID,
+		newUserID:  newUserID,
+		reason:     reason,
+		reassigned: time.Now(),
+	})
+}
+
+func truncateTime(t time.Time, bucket string) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if bucket == "week" {
+		day = day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	}
+	return day
+}
+
+func pageBounds(n, limit, offset int) (int, int) {
+	from := offset
+	if from > n {
+		from = n
+	}
+	to := from + limit
+	if to > n {
+		to = n
+	}
+	return from, to
+}
+
+func copyInt(v *int) *int {
+	if v == nil {
+		return nil
+	}
+	n := *v
+	return &n
+}
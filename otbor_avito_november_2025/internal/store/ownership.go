@@ -0,0 +1,67 @@
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+type PathOwner struct {
+	Pattern string `json:"pattern"`
+	UserID  string `json:"user_id"`
+}
+
+func (s *PostgresStore) GetPathOwners(ctx context.Context, teamName string) ([]PathOwner, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT pattern, user_id
+		FROM team_path_owners
+		WHERE team_name = $1
+		ORDER BY pattern, user_id
+	`
+	rows, err := s.db.QueryContext(ctx, query, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("get path owners: %w", err)
+	}
+	defer rows.Close()
+
+	var owners []PathOwner
+	for rows.Next() {
+		var owner PathOwner
+		if err := rows.Scan(&owner.Pattern, &owner.UserID); err != nil {
+			return nil, fmt.Errorf("get path owners: %w", err)
+		}
+		owners = append(owners, owner)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get path owners: %w", err)
+	}
+	return owners, nil
+}
+
+func (s *PostgresStore) ReplacePathOwners(ctx context.Context, teamName string, owners []PathOwner) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("replace path owners: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM team_path_owners WHERE team_name = $1`, teamName); err != nil {
+		return fmt.Errorf("replace path owners: %w", err)
+	}
+	for _, owner := range owners {
+		query := `INSERT INTO team_path_owners (team_name, pattern, user_id) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`
+		if _, err := tx.ExecContext(ctx, query, teamName, owner.Pattern, owner.UserID); err != nil {
+			return fmt.Errorf("replace path owners: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit replace path owners: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const pendingColumns = `team_name, pull_request_id, attempts, next_attempt_at, expires_at, created_at`
+
+type PendingAssignment struct {
+	TeamName      string    `json:"team_name"`
+	PullRequestID string    `json:"pull_request_id"`
+	Attempts      int       `json:"attempts"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	ExpiresAt     time.Time `json:"expires_at"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func (p *PendingAssignment) Key() PRKey {
+	return PRKey{TeamName: p.TeamName, PullRequestID: p.PullRequestID}
+}
+
+func (s *PostgresStore) EnqueuePendingAssignment(ctx context.Context, key PRKey, nextAttemptAt, expiresAt time.Time) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO pending_assignments (team_name, pull_request_id, next_attempt_at, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (team_name, pull_request_id) DO UPDATE SET next_attempt_at = $3, expires_at = $4
+	`
+	_, err := s.db.ExecContext(ctx, query, key.TeamName, key.PullRequestID, nextAttemptAt, expiresAt)
+	if err != nil {
+		return fmt.Errorf("enqueue pending assignment: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) GetDuePendingAssignments(ctx context.Context, now time.Time) ([]PendingAssignment, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `SELECT ` + pendingColumns + ` FROM pending_assignments WHERE next_attempt_at <= $1 ORDER BY next_attempt_at, team_name, pull_request_id`
+	rows, err := s.db.QueryContext(ctx, query, now)
+	if err != nil {
+		return nil, fmt.Errorf("get due pending assignments: %w", err)
+	}
+	defer rows.Close()
+
+	return scanPendingAssignments(rows)
+}
+
+func (s *PostgresStore) GetPendingAssignments(ctx context.Context) ([]PendingAssignment, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `SELECT ` + pendingColumns + ` FROM pending_assignments ORDER BY next_attempt_at, team_name, pull_request_id`
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("get pending assignments: %w", err)
+	}
+	defer rows.Close()
+
+	return scanPendingAssignments(rows)
+}
+
+func (s *PostgresStore) GetPendingAssignment(ctx context.Context, key PRKey) (*PendingAssignment, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `SELECT ` + pendingColumns + ` FROM pending_assignments WHERE team_name = $1 AND pull_request_id = $2`
+	var p PendingAssignment
+	err := s.db.QueryRowContext(ctx, query, key.TeamName, key.PullRequestID).Scan(&p.TeamName, &p.PullRequestID, &p.Attempts, &p.NextAttemptAt, &p.ExpiresAt, &p.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get pending assignment: %w", err)
+	}
+	return &p, nil
+}
+
+func (s *PostgresStore) ReschedulePendingAssignment(ctx context.Context, key PRKey, nextAttemptAt time.Time) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `UPDATE pending_assignments SET attempts = attempts + 1, next_attempt_at = $3 WHERE team_name = $1 AND pull_request_id = $2`
+	_, err := s.db.ExecContext(ctx, query, key.TeamName, key.PullRequestID, nextAttemptAt)
+	if err != nil {
+		return fmt.Errorf("reschedule pending assignment: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) DeletePendingAssignment(ctx context.Context, key PRKey) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	_, err := s.db.ExecContext(ctx, `DELETE FROM pending_assignments WHERE team_name = $1 AND pull_request_id = $2`, key.TeamName, key.PullRequestID)
+	if err != nil {
+		return fmt.Errorf("delete pending assignment: %w", err)
+	}
+	return nil
+}
+
+func scanPendingAssignments(rows *sql.Rows) ([]PendingAssignment, error) {
+	var pending []PendingAssignment
+	for rows.Next() {
+		var p PendingAssignment
+		if err := rows.Scan(&p.TeamName, &p.PullRequestID, &p.Attempts, &p.NextAttemptAt, &p.ExpiresAt, &p.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan pending assignments: %w", err)
+		}
+		pending = append(pending, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("scan pending assignments: %w", err)
+	}
+	return pending, nil
+}
@@ -0,0 +1,69 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type PoolPoint struct {
+	BucketStart      time.Time `json:"bucket_start"`
+	ActiveMembers    int       `json:"active_members"`
+	AvailableMembers int       `json:"available_members"`
+}
+
+func (s *PostgresStore) RecordPoolSnapshots(ctx context.Context, takenAt time.Time, busyThreshold int) (int64, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO team_pool_snapshots (team_name, taken_at, active_members, available_members)
+		SELECT t.name, $1, COUNT(u.user_id), COUNT(u.user_id) FILTER (WHERE COALESCE(o.open_reviews, 0) < $2)
+		FROM teams t
+		LEFT JOIN users u ON u.team_name = t.name AND u.is_active = true
+		LEFT JOIN (
+			SELECT r.user_id, COUNT(*) AS open_reviews
+			FROM pr_reviewers r
+			JOIN pull_requests p ON p.team_name = r.team_name AND p.pull_request_id = r.pull_request_id
+			WHERE p.status = $3
+			GROUP BY r.user_id
+		) o ON o.user_id = u.user_id
+		GROUP BY t.name
+		ON CONFLICT (team_name, taken_at) DO NOTHING
+	`
+	result, err := s.db.ExecContext(ctx, query, takenAt, busyThreshold, PRStatusOpen)
+	if err != nil {
+		return 0, fmt.Errorf("record pool snapshots: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+func (s *PostgresStore) GetPoolTrend(ctx context.Context, teamName, bucket string, since time.Time) ([]PoolPoint, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT DISTINCT ON (date_trunc($1, taken_at)) date_trunc($1, taken_at), active_members, available_members
+		FROM team_pool_snapshots
+		WHERE team_name = $2 AND taken_at >= $3
+		ORDER BY date_trunc($1, taken_at), taken_at DESC
+	`
+	rows, err := s.db.QueryContext(ctx, query, bucket, teamName, since)
+	if err != nil {
+		return nil, fmt.Errorf("get pool trend: %w", err)
+	}
+	defer rows.Close()
+
+	var points []PoolPoint
+	for rows.Next() {
+		var point PoolPoint
+		if err := rows.Scan(&point.BucketStart, &point.ActiveMembers, &point.AvailableMembers); err != nil {
+			return nil, fmt.Errorf("get pool trend: %w", err)
+		}
+		points = append(points, point)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get pool trend: %w", err)
+	}
+	return points, nil
+}
@@ -0,0 +1,70 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type QuotaUsage struct {
+	Quota    int
+	Assigned int
+}
+
+func (s *PostgresStore) GetWeeklyQuotaUsage(ctx context.Context, userIDs []string, weekStart time.Time) (map[string]QuotaUsage, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT u.user_id, COALESCE(u.weekly_quota, t.default_weekly_quota),
+		       (SELECT COUNT(*) FROM pr_reviewers r WHERE r.user_id = u.user_id AND r.assigned_at >= $2)
+		FROM users u
+		JOIN teams t ON t.name = u.team_name
+		WHERE u.user_id = ANY($1) AND COALESCE(u.weekly_quota, t.default_weekly_quota) IS NOT NULL
+	`
+	rows, err := s.db.QueryContext(ctx, query, pq.Array(userIDs), weekStart)
+	if err != nil {
+		return nil, fmt.Errorf("get weekly quota usage: %w", err)
+	}
+	defer rows.Close()
+
+	usage := make(map[string]QuotaUsage)
+	for rows.Next() {
+		var userID string
+		var u QuotaUsage
+		if err := rows.Scan(&userID, &u.Quota, &u.Assigned); err != nil {
+			return nil, fmt.Errorf("get weekly quota usage: %w", err)
+		}
+		usage[userID] = u
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get weekly quota usage: %w", err)
+	}
+	return usage, nil
+}
+
+func (s *PostgresStore) SetUserWeeklyQuota(ctx context.Context, userID string, quota *int) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `UPDATE users SET weekly_quota = $1 WHERE user_id = $2`
+	_, err := s.db.ExecContext(ctx, query, quota, userID)
+	if err != nil {
+		return fmt.Errorf("set user weekly quota: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) SetTeamDefaultWeeklyQuota(ctx context.Context, teamName string, quota *int) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `UPDATE teams SET default_weekly_quota = $1 WHERE name = $2`
+	_, err := s.db.ExecContext(ctx, query, quota, teamName)
+	if err != nil {
+		return fmt.Errorf("set team default weekly quota: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type ChurnPR struct {
+	PullRequest   PullRequest
+	Reassignments int
+	Reviewers     []string
+}
+
+type ReassignmentEvent struct {
+	OldUserID  *string   `json:"old_user_id"`
+	NewUserID  string    `json:"new_user_id"`
+	Reason     string    `json:"reason"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func (s *PostgresStore) ReplaceReviewer(ctx context.Context, key PRKey, oldUserID, newUserID string, reason AssignmentReason) (bool, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("replace reviewer: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, inserted, err := assignReviewer(ctx, tx, key, newUserID, reason)
+	if err != nil || !inserted {
+		return false, err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM pr_reviewers WHERE team_name = $1 AND pull_request_id = $2 AND user_id = $3`, key.TeamName, key.PullRequestID, oldUserID); err != nil {
+		return false, fmt.Errorf("replace reviewer: %w", err)
+	}
+	if err := logReassignment(ctx, tx, key, &oldUserID, newUserID, reason.Reason); err != nil {
+		return false, fmt.Errorf("replace reviewer: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("commit replace reviewer: %w", err)
+	}
+	return true, nil
+}
+
+func (s *PostgresStore) GetReassignmentEvents(ctx context.Context, key PRKey) ([]ReassignmentEvent, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT old_user_id, new_user_id, COALESCE(reason, ''), reassigned_at
+		FROM reassignment_log
+		WHERE team_name = $1 AND pull_request_id = $2
+		ORDER BY reassigned_at, id
+	`
+	rows, err := s.db.QueryContext(ctx, query, key.TeamName, key.PullRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("get reassignment events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []ReassignmentEvent
+	for rows.Next() {
+		var event ReassignmentEvent
+		if err := rows.Scan(&event.OldUserID, &event.NewUserID, &event.Reason, &event.OccurredAt); err != nil {
+			return nil, fmt.Errorf("get reassignment events: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get reassignment events: %w", err)
+	}
+	return events, nil
+}
+
+func (s *PostgresStore) GetHighChurnPRs(ctx context.Context, minReassigns, limit, offset int) ([]ChurnPR, int, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	churn := `
+		SELECT team_name, pull_request_id, COUNT(*) AS reassignments
+		FROM reassignment_log
+		WHERE old_user_id IS NOT NULL
+		GROUP BY team_name, pull_request_id
+		HAVING COUNT(*) >= $1
+	`
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM (` + churn + `) churn`
+	if err := s.db.QueryRowContext(ctx, countQuery, minReassigns).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("get high churn PRs: %w", err)
+	}
+
+	query := `
+		SELECT ` + prColumnsAliased + `, c.reassignments,
+		       ARRAY(SELECT r.user_id FROM pr_reviewers r WHERE r.team_name = p.team_name AND r.pull_request_id = p.pull_request_id ORDER BY r.user_id)
+		FROM (` + churn + `) c
+		JOIN pull_requests p ON p.team_name = c.team_name AND p.pull_request_id = c.pull_request_id
+		ORDER BY c.reassignments DESC, p.pull_request_id, p.team_name
+		LIMIT $2 OFFSET $3
+	`
+	rows, err := s.db.QueryContext(ctx, query, minReassigns, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get high churn PRs: %w", err)
+	}
+	defer rows.Close()
+
+	var prs []ChurnPR
+	for rows.Next() {
+		var churnPR ChurnPR
+		pr, err := scanPR(withExtra(rows, &churnPR.Reassignments, pq.Array(&churnPR.Reviewers)))
+		if err != nil {
+			return nil, 0, fmt.Errorf("get high churn PRs: %w", err)
+		}
+		churnPR.PullRequest = *pr
+		prs = append(prs, churnPR)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("get high churn PRs: %w", err)
+	}
+	return prs, total, nil
+}
+
+// logReassignment records a reviewer change in reassignment_log. A nil
+// oldUserID marks an initial assignment rather than a replacement.
+func logReassignment(ctx context.Context, db execer, key PRKey, oldUserID *string, newUserID, reason string) error {
+	query := `
+		INSERT INTO reassignment_log (team_name, pull_request_id, old_user_id, new_user_id, reason, reassigned_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err := db.ExecContext(ctx, query, key.TeamName, key.PullRequestID, oldUserID, newUserID, nullString(reason), time.Now())
+	if err != nil {
+		return fmt.Errorf("log reassignment: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+type OpenAssignment struct {
+	TeamName      string
+	PullRequestID string
+	AuthorID      string
+	UserID        string
+	Acknowledged  bool
+	Approved      bool
+}
+
+func (a *OpenAssignment) Key() PRKey {
+	return PRKey{TeamName: a.TeamName, PullRequestID: a.PullRequestID}
+}
+
+func (s *PostgresStore) GetTeamOpenAssignments(ctx context.Context, teamName string) ([]OpenAssignment, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT p.team_name, p.pull_request_id, p.author_id, r.user_id, r.acknowledged_at IS NOT NULL,
+			EXISTS (
+				SELECT 1 FROM pr_approvals a
+				WHERE a.team_name = r.team_name AND a.pull_request_id = r.pull_request_id AND a.user_id = r.user_id AND a.approved_at >= r.assigned_at
+			)
+		FROM pr_reviewers r
+		JOIN pull_requests p ON p.team_name = r.team_name AND p.pull_request_id = r.pull_request_id
+		JOIN users u ON u.user_id = r.user_id
+		WHERE u.team_name = $1 AND p.status = $2
+		ORDER BY p.created_at, p.pull_request_id, r.user_id
+	`
+	rows, err := s.db.QueryContext(ctx, query, teamName, PRStatusOpen)
+	if err != nil {
+		return nil, fmt.Errorf("get team open assignments: %w", err)
+	}
+	defer rows.Close()
+
+	var assignments []OpenAssignment
+	for rows.Next() {
+		var a OpenAssignment
+		if err := rows.Scan(&a.TeamName, &a.PullRequestID, &a.AuthorID, &a.UserID, &a.Acknowledged, &a.Approved); err != nil {
+			return nil, fmt.Errorf("get team open assignments: %w", err)
+		}
+		assignments = append(assignments, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get team open assignments: %w", err)
+	}
+	return assignments, nil
+}
+
+func (s *PostgresStore) SwapReviewer(ctx context.Context, key PRKey, oldUserID, newUserID string, reason AssignmentReason) (bool, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("swap reviewer: %w", err)
+	}
+	defer tx.Rollback()
+
+	var status PullRequestStatus
+	err = tx.QueryRowContext(ctx, `SELECT status FROM pull_requests WHERE team_name = $1 AND pull_request_id = $2 FOR UPDATE`, key.TeamName, key.PullRequestID).Scan(&status)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && status != PRStatusOpen) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("swap reviewer: %w", err)
+	}
+
+	removed, err := tx.ExecContext(ctx, `
+		DELETE FROM pr_reviewers r
+		WHERE r.team_name = $1 AND r.pull_request_id = $2 AND r.user_id = $3 AND r.acknowledged_at IS NULL
+		  AND NOT EXISTS (
+			SELECT 1 FROM pr_approvals a
+			WHERE a.team_name = r.team_name AND a.pull_request_id = r.pull_request_id AND a.user_id = r.user_id AND a.approved_at >= r.assigned_at
+		  )
+	`, key.TeamName, key.PullRequestID, oldUserID)
+	if err != nil {
+		return false, fmt.Errorf("swap reviewer: %w", err)
+	}
+	if n, err := removed.RowsAffected(); err != nil || n == 0 {
+		return false, err
+	}
+
+	_, inserted, err := assignReviewer(ctx, tx, key, newUserID, reason)
+	if err != nil || !inserted {
+		return false, err
+	}
+
+	if err := logReassignment(ctx, tx, key, &oldUserID, newUserID, reason.Reason); err != nil {
+		return false, fmt.Errorf("swap reviewer: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("commit swap reviewer: %w", err)
+	}
+	return true, nil
+}
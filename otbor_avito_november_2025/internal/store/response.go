@@ -0,0 +1,48 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type ResponseTime struct {
+	Samples    int
+	AvgSeconds float64
+}
+
+func (s *PostgresStore) GetResponseTimes(ctx context.Context, userIDs []string, since time.Time) (map[string]ResponseTime, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT r.user_id, COUNT(*),
+		       AVG(EXTRACT(EPOCH FROM (COALESCE(r.acknowledged_at, p.merged_at) - r.assigned_at)))
+		FROM pr_reviewers r
+		JOIN pull_requests p ON p.team_name = r.team_name AND p.pull_request_id = r.pull_request_id
+		WHERE r.user_id = ANY($1) AND r.assigned_at >= $2
+		  AND COALESCE(r.acknowledged_at, p.merged_at) IS NOT NULL
+		GROUP BY r.user_id
+	`
+	rows, err := s.db.QueryContext(ctx, query, pq.Array(userIDs), since)
+	if err != nil {
+		return nil, fmt.Errorf("get response times: %w", err)
+	}
+	defer rows.Close()
+
+	times := make(map[string]ResponseTime, len(userIDs))
+	for rows.Next() {
+		var userID string
+		var rt ResponseTime
+		if err := rows.Scan(&userID, &rt.Samples, &rt.AvgSeconds); err != nil {
+			return nil, fmt.Errorf("get response times: %w", err)
+		}
+		times[userID] = rt
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get response times: %w", err)
+	}
+	return times, nil
+}
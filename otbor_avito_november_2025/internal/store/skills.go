@@ -0,0 +1,63 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+func (s *PostgresStore) ReplaceUserSkills(ctx context.Context, userID string, skills []string) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("replace user skills: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_skills WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("replace user skills: %w", err)
+	}
+	query := `INSERT INTO user_skills (user_id, skill) SELECT $1, unnest($2::text[]) ON CONFLICT DO NOTHING`
+	if _, err := tx.ExecContext(ctx, query, userID, pq.Array(skills)); err != nil {
+		return fmt.Errorf("replace user skills: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit replace user skills: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) GetUsersWithSkills(ctx context.Context, userIDs, skills []string) (map[string]bool, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT user_id
+		FROM user_skills
+		WHERE user_id = ANY($1) AND skill = ANY($2)
+		GROUP BY user_id
+		HAVING COUNT(*) = $3
+	`
+	rows, err := s.db.QueryContext(ctx, query, pq.Array(userIDs), pq.Array(skills), len(skills))
+	if err != nil {
+		return nil, fmt.Errorf("get users with skills: %w", err)
+	}
+	defer rows.Close()
+
+	skilled := make(map[string]bool)
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("get users with skills: %w", err)
+		}
+		skilled[userID] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get users with skills: %w", err)
+	}
+	return skilled, nil
+}
@@ -0,0 +1,496 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+type TrendPoint struct {
+	BucketStart time.Time `json:"bucket_start"`
+	Assignments int       `json:"assignments"`
+}
+
+func (s *PostgresStore) GetAssignmentTrend(ctx context.Context, teamName, bucket string, since time.Time) ([]TrendPoint, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT date_trunc($1, r.assigned_at) AS bucket_start, COUNT(*)
+		FROM pr_reviewers r
+		JOIN users u ON u.user_id = r.user_id
+		WHERE u.team_name = $2 AND r.assigned_at >= $3
+		GROUP BY bucket_start
+		ORDER BY bucket_start
+	`
+	rows, err := s.db.QueryContext(ctx, query, bucket, teamName, since)
+	if err != nil {
+		return nil, fmt.Errorf("get assignment trend: %w", err)
+	}
+	defer rows.Close()
+
+	var points []TrendPoint
+	for rows.Next() {
+		var point TrendPoint
+		if err := rows.Scan(&point.BucketStart, &point.Assignments); err != nil {
+			return nil, fmt.Errorf("get assignment trend: %w", err)
+		}
+		points = append(points, point)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get assignment trend: %w", err)
+	}
+	return points, nil
+}
+
+func (s *PostgresStore) GetOpenReviewCounts(ctx context.Context, teamName string) (map[string]int, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT r.user_id, COUNT(*)
+		FROM pr_reviewers r
+		JOIN users u ON u.user_id = r.user_id
+		JOIN pull_requests p ON p.team_name = r.team_name AND p.pull_request_id = r.pull_request_id
+		WHERE u.team_name = $1 AND p.status = $2
+		GROUP BY r.user_id
+	`
+	rows, err := s.db.QueryContext(ctx, query, teamName, PRStatusOpen)
+	if err != nil {
+		return nil, fmt.Errorf("get open review counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var userID string
+		var count int
+		if err := rows.Scan(&userID, &count); err != nil {
+			return nil, fmt.Errorf("get open review counts: %w", err)
+		}
+		counts[userID] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get open review counts: %w", err)
+	}
+	return counts, nil
+}
+
+type MemberWithOpenPRs struct {
+	User    User
+	OpenPRs int
+}
+
+func (s *PostgresStore) GetActiveTeamMembersWithOpenPRCount(ctx context.Context, teamName string) ([]MemberWithOpenPRs, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT u.user_id, u.username, u.is_active, u.team_name, u.created_at, COUNT(p.pull_request_id)
+		FROM users u
+		LEFT JOIN pr_reviewers r ON r.user_id = u.user_id
+		LEFT JOIN pull_requests p ON p.team_name = r.team_name AND p.pull_request_id = r.pull_request_id AND p.status = $2
+		WHERE u.team_name = $1 AND u.is_active = true
+		GROUP BY u.user_id, u.username, u.is_active, u.team_name, u.created_at
+		ORDER BY u.user_id
+	`
+	rows, err := s.db.QueryContext(ctx, query, teamName, PRStatusOpen)
+	if err != nil {
+		return nil, fmt.Errorf("get active team members with open PR count: %w", err)
+	}
+	defer rows.Close()
+
+	var members []MemberWithOpenPRs
+	for rows.Next() {
+		var member MemberWithOpenPRs
+		user := &member.User
+		if err := rows.Scan(&user.UserID, &user.Username, &user.IsActive, &user.TeamName, &user.CreatedAt, &member.OpenPRs); err != nil {
+			return nil, fmt.Errorf("get active team members with open PR count: %w", err)
+		}
+		members = append(members, member)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get active team members with open PR count: %w", err)
+	}
+	return members, nil
+}
+
+type MemberOpenReviews struct {
+	TeamName    string `json:"team_name"`
+	UserID      string `json:"user_id"`
+	Username    string `json:"username"`
+	OpenReviews int    `json:"open_reviews"`
+}
+
+func (s *PostgresStore) GetActiveMemberOpenReviews(ctx context.Context) ([]MemberOpenReviews, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT u.team_name, u.user_id, u.username, COUNT(p.pull_request_id)
+		FROM users u
+		LEFT JOIN pr_reviewers r ON r.user_id = u.user_id
+		LEFT JOIN pull_requests p ON p.team_name = r.team_name AND p.pull_request_id = r.pull_request_id AND p.status = $1
+		WHERE u.is_active = true
+		GROUP BY u.team_name, u.user_id, u.username
+		ORDER BY u.team_name, u.user_id
+	`
+	rows, err := s.db.QueryContext(ctx, query, PRStatusOpen)
+	if err != nil {
+		return nil, fmt.Errorf("get active member open reviews: %w", err)
+	}
+	defer rows.Close()
+
+	var loads []MemberOpenReviews
+	for rows.Next() {
+		var load MemberOpenReviews
+		if err := rows.Scan(&load.TeamName, &load.UserID, &load.Username, &load.OpenReviews); err != nil {
+			return nil, fmt.Errorf("get active member open reviews: %w", err)
+		}
+		loads = append(loads, load)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get active member open reviews: %w", err)
+	}
+	return loads, nil
+}
+
+// GetOldestOpenPRs returns OPEN PRs on which no reviewer has acted yet: none
+// has acknowledged the review or left a valid approval.
+func (s *PostgresStore) GetOldestOpenPRs(ctx context.Context, limit int) ([]PullRequest, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + prColumnsAliased + `
+		FROM pull_requests p
+		WHERE p.status = $1
+		  AND NOT EXISTS (
+			SELECT 1 FROM pr_reviewers r
+			WHERE r.team_name = p.team_name AND r.pull_request_id = p.pull_request_id AND r.acknowledged_at IS NOT NULL
+		  )
+		  AND NOT EXISTS (
+			SELECT 1 FROM pr_approvals a
+			JOIN pr_reviewers r ON r.team_name = a.team_name AND r.pull_request_id = a.pull_request_id AND r.user_id = a.user_id
+			WHERE a.team_name = p.team_name AND a.pull_request_id = p.pull_request_id AND a.approved_at >= r.assigned_at
+		  )
+		ORDER BY p.created_at, p.pull_request_id
+		LIMIT $2
+	`
+	rows, err := s.db.QueryContext(ctx, query, PRStatusOpen, limit)
+	if err != nil {
+		return nil, fmt.Errorf("get oldest open PRs: %w", err)
+	}
+	defer rows.Close()
+
+	return s.scanPRs(rows)
+}
+
+type LeaderboardEntry struct {
+	Rank     int    `json:"rank"`
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Reviews  int    `json:"reviews"`
+}
+
+func (s *PostgresStore) GetReviewLeaderboard(ctx context.Context, teamName string, since time.Time, limit, offset int) ([]LeaderboardEntry, int, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM users WHERE team_name = $1`
+	if err := s.db.QueryRowContext(ctx, countQuery, teamName).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("get review leaderboard: %w", err)
+	}
+
+	query := `
+		SELECT ROW_NUMBER() OVER (ORDER BY COUNT(p.pull_request_id) DESC, u.username, u.user_id) AS rank,
+		       u.user_id, u.username, COUNT(p.pull_request_id)
+		FROM users u
+		LEFT JOIN pr_reviewers r ON r.user_id = u.user_id
+		LEFT JOIN pull_requests p ON p.team_name = r.team_name AND p.pull_request_id = r.pull_request_id
+		     AND p.status = $2 AND p.merged_at >= $3
+		WHERE u.team_name = $1
+		GROUP BY u.user_id, u.username
+		ORDER BY rank
+		LIMIT $4 OFFSET $5
+	`
+	rows, err := s.db.QueryContext(ctx, query, teamName, PRStatusMerged, since, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get review leaderboard: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []LeaderboardEntry
+	for rows.Next() {
+		var entry LeaderboardEntry
+		if err := rows.Scan(&entry.Rank, &entry.UserID, &entry.Username, &entry.Reviews); err != nil {
+			return nil, 0, fmt.Errorf("get review leaderboard: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("get review leaderboard: %w", err)
+	}
+	return entries, total, nil
+}
+
+type TeamSize struct {
+	TeamName string `json:"team_name"`
+	Members  int    `json:"members"`
+}
+
+func (s *PostgresStore) GetTeamsBySize(ctx context.Context, minMembers int, maxMembers *int, limit, offset int) ([]TeamSize, int, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	grouped := `
+		SELECT t.name, COUNT(u.user_id) AS members
+		FROM teams t
+		LEFT JOIN users u ON u.team_name = t.name
+		GROUP BY t.name
+		HAVING COUNT(u.user_id) >= $1 AND ($2::int IS NULL OR COUNT(u.user_id) <= $2)
+	`
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM (` + grouped + `) sized`
+	if err := s.db.QueryRowContext(ctx, countQuery, minMembers, maxMembers).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("get teams by size: %w", err)
+	}
+
+	query := grouped + ` ORDER BY members, t.name LIMIT $3 OFFSET $4`
+	rows, err := s.db.QueryContext(ctx, query, minMembers, maxMembers, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get teams by size: %w", err)
+	}
+	defer rows.Close()
+
+	var teams []TeamSize
+	for rows.Next() {
+		var team TeamSize
+		if err := rows.Scan(&team.TeamName, &team.Members); err != nil {
+			return nil, 0, fmt.Errorf("get teams by size: %w", err)
+		}
+		teams = append(teams, team)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("get teams by size: %w", err)
+	}
+	return teams, total, nil
+}
+
+// GetDeadlineCompliance counts PRs that were reviewed by their deadline. A PR
+// counts as reviewed when it reaches its team's required number of approvals
+// from assigned reviewers. A PR is counted once it is reviewed, merged or past
+// its deadline, so one merged with fewer approvals is never compliant.
+func (s *PostgresStore) GetDeadlineCompliance(ctx context.Context, teamName string, since, now time.Time) (int, int, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		WITH approvals AS (
+			SELECT a.team_name, a.pull_request_id, a.approved_at,
+				ROW_NUMBER() OVER (PARTITION BY a.team_name, a.pull_request_id ORDER BY a.approved_at) AS n
+			FROM pr_approvals a
+			JOIN pr_reviewers r ON r.team_name = a.team_name AND r.pull_request_id = a.pull_request_id AND r.user_id = a.user_id
+			WHERE a.approved_at >= r.assigned_at
+		), reviewed AS (
+			SELECT p.review_deadline, p.merged_at, a.approved_at AS reviewed_at
+			FROM pull_requests p
+			JOIN users author ON author.user_id = p.author_id
+			JOIN teams t ON t.name = COALESCE(p.team_name, author.team_name)
+			LEFT JOIN approvals a ON a.team_name = p.team_name AND a.pull_request_id = p.pull_request_id AND a.n = t.required_reviewers
+			WHERE author.team_name = $1 AND p.review_deadline IS NOT NULL AND p.created_at >= $2
+		)
+		SELECT COUNT(*) FILTER (WHERE reviewed_at <= review_deadline), COUNT(*)
+		FROM reviewed
+		WHERE reviewed_at IS NOT NULL OR merged_at IS NOT NULL OR review_deadline < $3
+	`
+	var compliant, total int
+	err := s.db.QueryRowContext(ctx, query, teamName, since, now).Scan(&compliant, &total)
+	if err != nil {
+		return 0, 0, fmt.Errorf("get deadline compliance: %w", err)
+	}
+	return compliant, total, nil
+}
+
+type CrossTeamReviewer struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Reviews  int    `json:"reviews"`
+}
+
+func (s *PostgresStore) GetCrossTeamReviewCounts(ctx context.Context, teamName string, since time.Time) ([]CrossTeamReviewer, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT u.user_id, u.username, COUNT(a.user_id)
+		FROM users u
+		LEFT JOIN pr_reviewers r ON r.user_id = u.user_id AND r.assigned_at >= $2
+		LEFT JOIN pull_requests p ON p.team_name = r.team_name AND p.pull_request_id = r.pull_request_id
+		LEFT JOIN users a ON a.user_id = p.author_id AND a.team_name <> u.team_name
+		WHERE u.team_name = $1
+		GROUP BY u.user_id, u.username
+		ORDER BY COUNT(a.user_id) DESC, u.user_id
+	`
+	rows, err := s.db.QueryContext(ctx, query, teamName, since)
+	if err != nil {
+		return nil, fmt.Errorf("get cross team review counts: %w", err)
+	}
+	defer rows.Close()
+
+	var reviewers []CrossTeamReviewer
+	for rows.Next() {
+		var reviewer CrossTeamReviewer
+		if err := rows.Scan(&reviewer.UserID, &reviewer.Username, &reviewer.Reviews); err != nil {
+			return nil, fmt.Errorf("get cross team review counts: %w", err)
+		}
+		reviewers = append(reviewers, reviewer)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get cross team review counts: %w", err)
+	}
+	return reviewers, nil
+}
+
+type MemberReviewStats struct {
+	UserID        string `json:"user_id"`
+	Username      string `json:"username"`
+	OpenReviews   int    `json:"open_reviews"`
+	MergedReviews int    `json:"merged_reviews"`
+}
+
+func (s *PostgresStore) GetTeamReviewStats(ctx context.Context, teamName string) ([]MemberReviewStats, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT u.user_id, u.username,
+			COUNT(p.pull_request_id) FILTER (WHERE p.status = $2),
+			COUNT(p.pull_request_id) FILTER (WHERE p.status = $3)
+		FROM users u
+		LEFT JOIN pr_reviewers r ON r.user_id = u.user_id
+		LEFT JOIN pull_requests p ON p.team_name = r.team_name AND p.pull_request_id = r.pull_request_id
+		WHERE u.team_name = $1 AND u.is_active = true
+		GROUP BY u.user_id, u.username
+		ORDER BY u.user_id
+	`
+	rows, err := s.db.QueryContext(ctx, query, teamName, PRStatusOpen, PRStatusMerged)
+	if err != nil {
+		return nil, fmt.Errorf("get team review stats: %w", err)
+	}
+	defer rows.Close()
+
+	var members []MemberReviewStats
+	for rows.Next() {
+		var member MemberReviewStats
+		if err := rows.Scan(&member.UserID, &member.Username, &member.OpenReviews, &member.MergedReviews); err != nil {
+			return nil, fmt.Errorf("get team review stats: %w", err)
+		}
+		members = append(members, member)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get team review stats: %w", err)
+	}
+	return members, nil
+}
+
+type StrategyOutcome struct {
+	Strategy              string   `json:"strategy"`
+	Assignments           int      `json:"assignments"`
+	PullRequests          int      `json:"pull_requests"`
+	LoadVariance          *float64 `json:"load_variance"`
+	AvgFirstReviewSeconds *float64 `json:"avg_first_review_seconds"`
+}
+
+func (s *PostgresStore) GetStrategyOutcomes(ctx context.Context, since time.Time) ([]StrategyOutcome, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		WITH loads AS (
+			SELECT assignment_strategy AS strategy, COUNT(*) AS assignments, VAR_POP(load_at_assignment) AS load_variance
+			FROM pr_reviewers
+			WHERE assigned_at >= $1 AND COALESCE(assignment_strategy, '') <> ''
+			GROUP BY assignment_strategy
+		), first_reviews AS (
+			SELECT r.assignment_strategy AS strategy, r.team_name, r.pull_request_id,
+			       EXTRACT(EPOCH FROM MIN(r.acknowledged_at) - p.created_at) AS seconds
+			FROM pr_reviewers r
+			JOIN pull_requests p ON p.team_name = r.team_name AND p.pull_request_id = r.pull_request_id
+			WHERE r.assigned_at >= $1 AND COALESCE(r.assignment_strategy, '') <> ''
+			GROUP BY r.assignment_strategy, r.team_name, r.pull_request_id, p.created_at
+		)
+		SELECT l.strategy, l.assignments, COUNT(f.pull_request_id), l.load_variance, AVG(f.seconds)
+		FROM loads l
+		JOIN first_reviews f ON f.strategy = l.strategy
+		GROUP BY l.strategy, l.assignments, l.load_variance
+		ORDER BY l.strategy
+	`
+	rows, err := s.db.QueryContext(ctx, query, since)
+	if err != nil {
+		return nil, fmt.Errorf("get strategy outcomes: %w", err)
+	}
+	defer rows.Close()
+
+	var outcomes []StrategyOutcome
+	for rows.Next() {
+		var outcome StrategyOutcome
+		var variance, avgSeconds sql.NullFloat64
+		if err := rows.Scan(&outcome.Strategy, &outcome.Assignments, &outcome.PullRequests, &variance, &avgSeconds); err != nil {
+			return nil, fmt.Errorf("get strategy outcomes: %w", err)
+		}
+		if variance.Valid {
+			outcome.LoadVariance = &variance.Float64
+		}
+		if avgSeconds.Valid {
+			outcome.AvgFirstReviewSeconds = &avgSeconds.Float64
+		}
+		outcomes = append(outcomes, outcome)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get strategy outcomes: %w", err)
+	}
+	return outcomes, nil
+}
+
+type UserAssignmentCount struct {
+	TeamName    string `json:"team_name"`
+	UserID      string `json:"user_id"`
+	Username    string `json:"username"`
+	Assignments int    `json:"assignments"`
+}
+
+func (s *PostgresStore) GetActiveUserAssignmentCounts(ctx context.Context, since time.Time) ([]UserAssignmentCount, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT u.team_name, u.user_id, u.username, COUNT(r.pull_request_id)
+		FROM users u
+		LEFT JOIN pr_reviewers r ON r.user_id = u.user_id AND r.assigned_at >= $1
+		WHERE u.is_active = true
+		GROUP BY u.team_name, u.user_id, u.username
+		ORDER BY COUNT(r.pull_request_id) DESC, u.user_id
+	`
+	rows, err := s.db.QueryContext(ctx, query, since)
+	if err != nil {
+		return nil, fmt.Errorf("get active user assignment counts: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []UserAssignmentCount
+	for rows.Next() {
+		var count UserAssignmentCount
+		if err := rows.Scan(&count.TeamName, &count.UserID, &count.Username, &count.Assignments); err != nil {
+			return nil, fmt.Errorf("get active user assignment counts: %w", err)
+		}
+		counts = append(counts, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get active user assignment counts: %w", err)
+	}
+	return counts, nil
+}
@@ -1,245 +1,725 @@
-package store
-
-import (
-	"context"
-	"database/sql"
-	"time"
-)
-
-type Team struct {
-	Name      string    `json:"name"`
-	CreatedAt time.Time `json:"created_at"`
-}
-
-type User struct {
-	UserID    string    `json:"user_id"`
-	Username  string    `json:"username"`
-	IsActive  bool      `json:"is_active"`
-	TeamName  string    `json:"team_name"`
-	CreatedAt time.Time `json:"created_at"`
-}
-
-type PullRequestStatus string
-
-const (
-	PRStatusOpen   PullRequestStatus = "OPEN"
-	PRStatusMerged PullRequestStatus = "MERGED"
-)
-
-type PullRequest struct {
-	PullRequestID   string            `json:"pull_request_id"`
-	PullRequestName string            `json:"pull_request_name"`
-	AuthorID        string            `json:"author_id"`
-	Status          PullRequestStatus `json:"status"`
-	CreatedAt       time.Time         `json:"created_at"`
-	MergedAt        *time.Time        `json:"merged_at"`
-}
-
-type PostgresStore struct {
-	db *sql.DB
-}
-
-func NewPostgresStore(db *sql.DB) *PostgresStore {
-	return &PostgresStore{db: db}
-}
-
-func (s *PostgresStore) CreateTeam(ctx context.Context, team *Team) error {
-	query := `INSERT INTO teams (name, created_at) VALUES ($1, $2)`
-	_, err := s.db.ExecContext(ctx, query, team.Name, time.Now())
-	return err
-}
-
-func (s *PostgresStore) GetTeam(ctx context.Context, name string) (*Team, error) {
-	query := `SELECT name, created_at FROM teams WHERE name = $1`
-	row := s.db.QueryRowContext(ctx, query, name)
-
-	var team Team
-	err := row.Scan(&team.Name, &team.CreatedAt)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
-		}
-		return nil, err
-	}
-	return &team, nil
-}
-
-func (s *PostgresStore) GetTeamMembers(ctx context.Context, teamName string) ([]User, error) {
-	query := `SELECT user_id, username, is_active, team_name, created_at FROM users WHERE team_name = $1`
-	rows, err := s.db.QueryContext(ctx, query, teamName)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var users []User
-	for rows.Next() {
-		var user User
-		err := rows.Scan(&user.UserID, &user.Username, &user.IsActive, &user.TeamName, &user.CreatedAt)
-		if err != nil {
-			return nil, err
-		}
-		users = append(users, user)
-	}
-	return users, nil
-}
-
-func (s *PostgresStore) CreateOrUpdateUser(ctx context.Context, user *User) error {
-	query := `
-		INSERT INTO users (user_id, username, is_active, team_name, created_at) 
-		VALUES ($1, $2, $3, $4, $5)
-		ON CONFLICT (user_id) 
-		DO UPDATE SET username = $2, is_active = $3, team_name = $4
-	`
-	_, err := s.db.ExecContext(ctx, query,
-		user.UserID, user.Username, user.IsActive, user.TeamName, time.Now())
-	return err
-}
-
-func (s *PostgresStore) GetUser(ctx context.Context, userID string) (*User, error) {
-	query := `SELECT user_id, username, is_active, team_name, created_at FROM users WHERE user_id = $1`
-	row := s.db.QueryRowContext(ctx, query, userID)
-
-	var user User
-	err := row.Scan(&user.UserID, &user.Username, &user.IsActive, &user.TeamName, &user.CreatedAt)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
-	return &user, err
-}
-
-func (s *PostgresStore) UpdateUser(ctx context.Context, user *User) error {
-	query := `UPDATE users SET username = $1, is_active = $2, team_name = $3 WHERE user_id = $4`
-	_, err := s.db.ExecContext(ctx, query, user.Username, user.IsActive, user.TeamName, user.UserID)
-	return err
-}
-
-func (s *PostgresStore) GetActiveTeamMembers(ctx context.Context, teamName string, excludeUserID *string) ([]User, error) {
-	query := `SELECT user_id, username, is_active, team_name, created_at FROM users WHERE team_name = $1 AND is_active = true`
-
-	if excludeUserID != nil {
-		query += " AND user_id != $2"
-		rows, err := s.db.QueryContext(ctx, query, teamName, *excludeUserID)
-		if err != nil {
-			return nil, err
-		}
-		defer rows.Close()
-
-		return s.scanUsers(rows)
-	}
-
-	rows, err := s.db.QueryContext(ctx, query, teamName)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	return s.scanUsers(rows)
-}
-
-func (s *PostgresStore) CreatePR(ctx context.Context, pr *PullRequest) error {
-	query := `
-		INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, created_at) 
-		VALUES ($1, $2, $3, $4, $5)
-	`
-	_, err := s.db.ExecContext(ctx, query,
-		pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status, time.Now())
-	return err
-}
-
-func (s *PostgresStore) GetPR(ctx context.Context, prID string) (*PullRequest, error) {
-	query := `SELECT pull_request_id, pull_request_name, author_id, status, created_at, merged_at FROM pull_requests WHERE pull_request_id = $1`
-	row := s.db.QueryRowContext(ctx, query, prID)
-
-	var pr PullRequest
-	var mergedAt sql.NullTime
-	err := row.Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &mergedAt)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
-	if mergedAt.Valid {
-		pr.MergedAt = &mergedAt.Time
-	}
-	return &pr, err
-}
-
-func (s *PostgresStore) UpdatePR(ctx context.Context, pr *PullRequest) error {
-	query := `
-		UPDATE pull_requests 
-		SET pull_request_name = $1, status = $2, merged_at = $3 
-		WHERE pull_request_id = $4
-	`
-	_, err := s.db.ExecContext(ctx, query,
-		pr.PullRequestName, pr.Status, pr.MergedAt, pr.PullRequestID)
-	return err
-}
-
-func (s *PostgresStore) AssignReviewer(ctx context.Context, prID, userID string) error {
-	query := `INSERT INTO pr_reviewers (pull_request_id, user_id, assigned_at) VALUES ($1, $2, $3)`
-	_, err := s.db.ExecContext(ctx, query, prID, userID, time.Now())
-	return err
-}
-
-func (s *PostgresStore) GetPRReviewers(ctx context.Context, prID string) ([]User, error) {
-	query := `
-		SELECT u.user_id, u.username, u.is_active, u.team_name, u.created_at 
-		FROM users u
-		JOIN pr_reviewers pr ON u.user_id = pr.user_id
-		WHERE pr.pull_request_id = $1
-	`
-	rows, err := s.db.QueryContext(ctx, query, prID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	return s.scanUsers(rows)
-}
-
-func (s *PostgresStore) RemoveReviewer(ctx context.Context, prID, userID string) error {
-	query := `DELETE FROM pr_reviewers WHERE pull_request_id = $1 AND user_id = $2`
-	_, err := s.db.ExecContext(ctx, query, prID, userID)
-	return err
-}
-
-func (s *PostgresStore) GetUserAssignedPRs(ctx context.Context, userID string) ([]PullRequest, error) {
-	query := `
-		SELECT p.pull_request_id, p.pull_request_name, p.author_id, p.status, p.created_at, p.merged_at
-		FROM pull_requests p
-		JOIN pr_reviewers pr ON p.pull_request_id = pr.pull_request_id
-		WHERE pr.user_id = $1
-	`
-	rows, err := s.db.QueryContext(ctx, query, userID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var prs []PullRequest
-	for rows.Next() {
-		var pr PullRequest
-		var mergedAt sql.NullTime
-		err := rows.Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &mergedAt)
-		if err != nil {
-			return nil, err
-		}
-		if mergedAt.Valid {
-			pr.MergedAt = &mergedAt.Time
-		}
-		prs = append(prs, pr)
-	}
-	return prs, nil
-}
-
-func (s *PostgresStore) scanUsers(rows *sql.Rows) ([]User, error) {
-	var users []User
-	for rows.Next() {
-		var user User
-		err := rows.Scan(&user.UserID, &user.Username, &user.IsActive, &user.TeamName, &user.CreatedAt)
-		if err != nil {
-			return nil, err
-		}
-		users = append(users, user)
-	}
-	return users, nil
-}
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// ErrDuplicateKey is returned when a write collides with an existing row,
+// e.g. two concurrent requests creating the same team or PR.
+var ErrDuplicateKey = errors.New("duplicate key value violates unique constraint")
+
+// ErrAmbiguousPR is returned when a PR key without a team matches PRs of
+// several teams, which is only possible with team-scoped PR ids.
+var ErrAmbiguousPR = errors.New("pull request id is used by several teams")
+
+const pqUniqueViolation = "23505"
+
+type Team struct {
+	Name              string    `json:"name"`
+	CreatedAt         time.Time `json:"created_at"`
+	RequiredReviewers int       `json:"required_reviewers"`
+}
+
+type User struct {
+	UserID    string    `json:"user_id"`
+	Username  string    `json:"username"`
+	IsActive  bool      `json:"is_active"`
+	TeamName  string    `json:"team_name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type PullRequestStatus string
+
+const (
+	PRStatusOpen   PullRequestStatus = "OPEN"
+	PRStatusMerged PullRequestStatus = "MERGED"
+	PRStatusClosed PullRequestStatus = "CLOSED"
+)
+
+type PullRequest struct {
+	PullRequestID   string            `json:"pull_request_id"`
+	PullRequestName string            `json:"pull_request_name"`
+	AuthorID        string            `json:"author_id"`
+	Status          PullRequestStatus `json:"status"`
+	CreatedAt       time.Time         `json:"created_at"`
+	MergedAt        *time.Time        `json:"merged_at"`
+	ReviewDeadline  *time.Time        `json:"review_deadline"`
+	TeamName        string            `json:"team_name"`
+}
+
+// PRKey identifies a pull request. PR ids are unique across teams by
+// default; with team-scoped ids the same id can exist in several teams and
+// TeamName tells them apart. An empty TeamName matches the id in any team.
+type PRKey struct {
+	TeamName      string
+	PullRequestID string
+}
+
+func (k PRKey) String() string {
+	if k.TeamName == "" {
+		return k.PullRequestID
+	}
+	return k.TeamName + "/" + k.PullRequestID
+}
+
+func (pr *PullRequest) Key() PRKey {
+	return PRKey{TeamName: pr.TeamName, PullRequestID: pr.PullRequestID}
+}
+
+const (
+	prColumns        = `pull_request_id, pull_request_name, author_id, status, created_at, merged_at, review_deadline, team_name`
+	prColumnsAliased = `p.pull_request_id, p.pull_request_name, p.author_id, p.status, p.created_at, p.merged_at, p.review_deadline, p.team_name`
+)
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+type rowQuerier interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+type Store interface {
+	CreateTeam(ctx context.Context, team *Team) error
+	CreateTeamWithMembers(ctx context.Context, team *Team, members []User) error
+	UpdateTeamWithMembers(ctx context.Context, team *Team, members []User) error
+	GetTeam(ctx context.Context, name string) (*Team, error)
+	GetTeamMembers(ctx context.Context, teamName string) ([]User, error)
+	CreateOrUpdateUser(ctx context.Context, user *User) error
+	GetUser(ctx context.Context, userID string) (*User, error)
+	UpdateUser(ctx context.Context, user *User) error
+	GetActiveTeamMembers(ctx context.Context, teamName string, excludeUserID *string) ([]User, error)
+	CreatePR(ctx context.Context, pr *PullRequest) error
+	CreatePRWithReviewers(ctx context.Context, pr *PullRequest, reviewers []ReviewerAssignment) (map[string]int, error)
+	GetPR(ctx context.Context, key PRKey) (*PullRequest, error)
+	UpdatePR(ctx context.Context, pr *PullRequest) error
+	AssignReviewer(ctx context.Context, key PRKey, userID string, reason AssignmentReason) (int, bool, error)
+	GetPRReviewers(ctx context.Context, key PRKey) ([]User, error)
+	GetRecentAuthorReviewers(ctx context.Context, authorID string, limit int) ([]string, error)
+	RemoveReviewer(ctx context.Context, key PRKey, userID string) error
+	GetUserAssignedPRs(ctx context.Context, userID string, status *PullRequestStatus, limit, offset int) ([]PullRequest, int, error)
+
+	AcknowledgeReview(ctx context.Context, key PRKey, userID string, now time.Time) (bool, error)
+	GetPRAcknowledgements(ctx context.Context, key PRKey) ([]ReviewerAcknowledgement, error)
+
+	ApprovePR(ctx context.Context, key PRKey, userID string, now time.Time) (bool, error)
+	GetPRApprovals(ctx context.Context, key PRKey) ([]Approval, error)
+	CountPRApprovals(ctx context.Context, key PRKey) (int, error)
+
+	ReplaceUserAPIKey(ctx context.Context, userID, keyHash string, createdAt time.Time) error
+	RevokeUserAPIKeys(ctx context.Context, userID string, revokedAt time.Time) (int64, error)
+	GetAPIKeyUser(ctx context.Context, keyHash string, usedAt time.Time) (string, error)
+
+	GetUserAssignments(ctx context.Context, userID string, since, until time.Time, limit, offset int) ([]Assignment, int, error)
+	GetPRAssignmentReasons(ctx context.Context, key PRKey) ([]ReviewerAssignment, error)
+	GetUserReviewIntervals(ctx context.Context, userID string, since time.Time) ([]ReviewInterval, error)
+
+	GetBlackoutWindows(ctx context.Context, teamName string) ([]BlackoutWindow, error)
+	CreateBlackoutWindow(ctx context.Context, window *BlackoutWindow) error
+
+	GetUnderReviewedPRs(ctx context.Context, teamName string, required int) ([]ReviewerCount, error)
+
+	GetOverduePRs(ctx context.Context, now time.Time) ([]PullRequest, error)
+	RecordEscalation(ctx context.Context, key PRKey, userID string) error
+
+	StreamReviewExport(ctx context.Context, since, until time.Time, fn func(ReviewExportRow) error) error
+	StreamReviewMatrix(ctx context.Context, fn func(ReviewMatrixRow) error) error
+
+	GetFeatureFlags(ctx context.Context) (map[string]bool, error)
+
+	FixPRStatusInconsistencies(ctx context.Context, dryRun bool) ([]PRStatusFix, error)
+	GetInactiveReviewerAssignments(ctx context.Context) ([]InactiveAssignment, error)
+	GetSelfReviews(ctx context.Context) ([]SelfReview, error)
+	RemoveSelfReviews(ctx context.Context) ([]SelfReview, error)
+
+	GetPathOwners(ctx context.Context, teamName string) ([]PathOwner, error)
+	ReplacePathOwners(ctx context.Context, teamName string, owners []PathOwner) error
+
+	GetFallbackTeams(ctx context.Context, teamName string) ([]string, error)
+	ReplaceFallbackTeams(ctx context.Context, teamName string, fallbackTeams []string) error
+
+	EnqueuePendingAssignment(ctx context.Context, key PRKey, nextAttemptAt, expiresAt time.Time) error
+	GetDuePendingAssignments(ctx context.Context, now time.Time) ([]PendingAssignment, error)
+	GetPendingAssignments(ctx context.Context) ([]PendingAssignment, error)
+	GetPendingAssignment(ctx context.Context, key PRKey) (*PendingAssignment, error)
+	ReschedulePendingAssignment(ctx context.Context, key PRKey, nextAttemptAt time.Time) error
+	DeletePendingAssignment(ctx context.Context, key PRKey) error
+
+	GetWeeklyQuotaUsage(ctx context.Context, userIDs []string, weekStart time.Time) (map[string]QuotaUsage, error)
+	SetUserWeeklyQuota(ctx context.Context, userID string, quota *int) error
+	SetTeamDefaultWeeklyQuota(ctx context.Context, teamName string, quota *int) error
+
+	ReplaceReviewer(ctx context.Context, key PRKey, oldUserID, newUserID string, reason AssignmentReason) (bool, error)
+	GetReassignmentEvents(ctx context.Context, key PRKey) ([]ReassignmentEvent, error)
+	GetHighChurnPRs(ctx context.Context, minReassigns, limit, offset int) ([]ChurnPR, int, error)
+
+	GetTeamOpenAssignments(ctx context.Context, teamName string) ([]OpenAssignment, error)
+	SwapReviewer(ctx context.Context, key PRKey, oldUserID, newUserID string, reason AssignmentReason) (bool, error)
+
+	ReplaceUserSkills(ctx context.Context, userID string, skills []string) error
+	GetUsersWithSkills(ctx context.Context, userIDs, skills []string) (map[string]bool, error)
+
+	GetAssignmentTrend(ctx context.Context, teamName, bucket string, since time.Time) ([]TrendPoint, error)
+	GetOpenReviewCounts(ctx context.Context, teamName string) (map[string]int, error)
+	GetActiveTeamMembersWithOpenPRCount(ctx context.Context, teamName string) ([]MemberWithOpenPRs, error)
+	GetActiveMemberOpenReviews(ctx context.Context) ([]MemberOpenReviews, error)
+	GetOldestOpenPRs(ctx context.Context, limit int) ([]PullRequest, error)
+	GetReviewLeaderboard(ctx context.Context, teamName string, since time.Time, limit, offset int) ([]LeaderboardEntry, int, error)
+	GetTeamsBySize(ctx context.Context, minMembers int, maxMembers *int, limit, offset int) ([]TeamSize, int, error)
+	GetDeadlineCompliance(ctx context.Context, teamName string, since, now time.Time) (int, int, error)
+	GetCrossTeamReviewCounts(ctx context.Context, teamName string, since time.Time) ([]CrossTeamReviewer, error)
+	GetTeamReviewStats(ctx context.Context, teamName string) ([]MemberReviewStats, error)
+	GetStrategyOutcomes(ctx context.Context, since time.Time) ([]StrategyOutcome, error)
+	GetActiveUserAssignmentCounts(ctx context.Context, since time.Time) ([]UserAssignmentCount, error)
+
+	CreateWebhookSubscription(ctx context.Context, sub *WebhookSubscription) error
+	GetWebhookSubscriptions(ctx context.Context) ([]WebhookSubscription, error)
+	GetWebhookSubscription(ctx context.Context, id int64) (*WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, id int64) (bool, error)
+
+	RecordPoolSnapshots(ctx context.Context, takenAt time.Time, busyThreshold int) (int64, error)
+	GetPoolTrend(ctx context.Context, teamName, bucket string, since time.Time) ([]PoolPoint, error)
+
+	GetReviewWeights(ctx context.Context, userIDs []string, now time.Time) (map[string]float64, error)
+	SetUserBoost(ctx context.Context, userID string, factor float64, expiresAt time.Time) error
+
+	GetResponseTimes(ctx context.Context, userIDs []string, since time.Time) (map[string]ResponseTime, error)
+
+	BackfillAuthors(ctx context.Context, fallbackTeam string, batchSize int) ([]PlaceholderUser, error)
+}
+
+type PostgresStore struct {
+	db           *sql.DB
+	queryTimeout time.Duration
+}
+
+var _ Store = (*PostgresStore)(nil)
+
+const defaultQueryTimeout = 5 * time.Second
+
+func NewPostgresStore(db *sql.DB, queryTimeout time.Duration) *PostgresStore {
+	if queryTimeout <= 0 {
+		queryTimeout = defaultQueryTimeout
+	}
+	return &PostgresStore{db: db, queryTimeout: queryTimeout}
+}
+
+func (s *PostgresStore) queryContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, s.queryTimeout)
+}
+
+func (s *PostgresStore) CreateTeam(ctx context.Context, team *Team) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+	return createTeam(ctx, s.db, team)
+}
+
+func (s *PostgresStore) CreateTeamWithMembers(ctx context.Context, team *Team, members []User) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("create team with members: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := createTeam(ctx, tx, team); err != nil {
+		return fmt.Errorf("create team with members: %w", err)
+	}
+	for i := range members {
+		if err := upsertUser(ctx, tx, &members[i]); err != nil {
+			return fmt.Errorf("create team with members: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit team %s: %w", team.Name, err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) UpdateTeamWithMembers(ctx context.Context, team *Team, members []User) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("update team with members: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE teams SET required_reviewers = $2 WHERE name = $1`
+	if _, err := tx.ExecContext(ctx, query, team.Name, team.RequiredReviewers); err != nil {
+		return fmt.Errorf("update team %s: %w", team.Name, err)
+	}
+	for i := range members {
+		if err := upsertUser(ctx, tx, &members[i]); err != nil {
+			return fmt.Errorf("update team with members: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit team %s: %w", team.Name, err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) GetTeam(ctx context.Context, name string) (*Team, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `SELECT name, created_at, required_reviewers FROM teams WHERE name = $1`
+	row := s.db.QueryRowContext(ctx, query, name)
+
+	var team Team
+	err := row.Scan(&team.Name, &team.CreatedAt, &team.RequiredReviewers)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get team %s: %w", name, err)
+	}
+	return &team, nil
+}
+
+func (s *PostgresStore) GetTeamMembers(ctx context.Context, teamName string) ([]User, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `SELECT user_id, username, is_active, team_name, created_at FROM users WHERE team_name = $1`
+	rows, err := s.db.QueryContext(ctx, query, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("get members of team %s: %w", teamName, err)
+	}
+	defer rows.Close()
+
+	users, err := s.scanUsers(rows)
+	if err != nil {
+		return nil, fmt.Errorf("get members of team %s: %w", teamName, err)
+	}
+	return users, nil
+}
+
+func (s *PostgresStore) CreateOrUpdateUser(ctx context.Context, user *User) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+	return upsertUser(ctx, s.db, user)
+}
+
+func (s *PostgresStore) GetUser(ctx context.Context, userID string) (*User, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `SELECT user_id, username, is_active, team_name, created_at FROM users WHERE user_id = $1`
+	row := s.db.QueryRowContext(ctx, query, userID)
+
+	var user User
+	err := row.Scan(&user.UserID, &user.Username, &user.IsActive, &user.TeamName, &user.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get user %s: %w", userID, err)
+	}
+	return &user, nil
+}
+
+func (s *PostgresStore) UpdateUser(ctx context.Context, user *User) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `UPDATE users SET username = $1, is_active = $2, team_name = $3 WHERE user_id = $4`
+	if _, err := s.db.ExecContext(ctx, query, user.Username, user.IsActive, user.TeamName, user.UserID); err != nil {
+		return fmt.Errorf("update user %s: %w", user.UserID, err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) GetActiveTeamMembers(ctx context.Context, teamName string, excludeUserID *string) ([]User, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `SELECT user_id, username, is_active, team_name, created_at FROM users WHERE team_name = $1 AND is_active = true`
+	args := []interface{}{teamName}
+	if excludeUserID != nil {
+		query += " AND user_id != $2"
+		args = append(args, *excludeUserID)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("get active members of team %s: %w", teamName, err)
+	}
+	defer rows.Close()
+
+	users, err := s.scanUsers(rows)
+	if err != nil {
+		return nil, fmt.Errorf("get active members of team %s: %w", teamName, err)
+	}
+	return users, nil
+}
+
+func (s *PostgresStore) CreatePR(ctx context.Context, pr *PullRequest) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+	return createPR(ctx, s.db, pr)
+}
+
+func (s *PostgresStore) CreatePRWithReviewers(ctx context.Context, pr *PullRequest, reviewers []ReviewerAssignment) (map[string]int, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create PR with reviewers: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := createPR(ctx, tx, pr); err != nil {
+		return nil, fmt.Errorf("create PR with reviewers: %w", err)
+	}
+	loads := make(map[string]int, len(reviewers))
+	for _, reviewer := range reviewers {
+		load, inserted, err := assignReviewer(ctx, tx, pr.Key(), reviewer.UserID, reviewer.Reason)
+		if err != nil {
+			return nil, fmt.Errorf("create PR with reviewers: %w", err)
+		}
+		if !inserted {
+			return nil, ErrDuplicateKey
+		}
+		if err := logReassignment(ctx, tx, pr.Key(), nil, reviewer.UserID, reviewer.Reason.Reason); err != nil {
+			return nil, fmt.Errorf("create PR with reviewers: %w", err)
+		}
+		loads[reviewer.UserID] = load
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit PR %s: %w", pr.PullRequestID, err)
+	}
+	return loads, nil
+}
+
+func (s *PostgresStore) GetPR(ctx context.Context, key PRKey) (*PullRequest, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + prColumns + ` FROM pull_requests
+		WHERE pull_request_id = $1 AND ($2::varchar = '' OR team_name = $2)
+		LIMIT 2
+	`
+	rows, err := s.db.QueryContext(ctx, query, key.PullRequestID, key.TeamName)
+	if err != nil {
+		return nil, fmt.Errorf("get PR %s: %w", key, err)
+	}
+	defer rows.Close()
+
+	prs, err := s.scanPRs(rows)
+	if err != nil {
+		return nil, fmt.Errorf("get PR %s: %w", key, err)
+	}
+	switch len(prs) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &prs[0], nil
+	default:
+		return nil, fmt.Errorf("get PR %s: %w", key, ErrAmbiguousPR)
+	}
+}
+
+func (s *PostgresStore) UpdatePR(ctx context.Context, pr *PullRequest) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE pull_requests 
+		SET pull_request_name = $1, status = $2, merged_at = $3, updated_at = NOW() 
+		WHERE team_name = $4 AND pull_request_id = $5
+	`
+	_, err := s.db.ExecContext(ctx, query,
+		pr.PullRequestName, pr.Status, pr.MergedAt, pr.TeamName, pr.PullRequestID)
+	if err != nil {
+		return fmt.Errorf("update PR %s: %w", pr.Key(), err)
+	}
+	return nil
+}
+
+// SetTeamScopedPRIDs chooses whether PR ids are unique per team or, by
+// default, across all teams. Global ids are enforced by a unique index on
+// pull_request_id, which can't be restored once two teams share an id.
+func (s *PostgresStore) SetTeamScopedPRIDs(ctx context.Context, enabled bool) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `CREATE UNIQUE INDEX IF NOT EXISTS idx_pull_requests_global_id ON pull_requests(pull_request_id)`
+	if enabled {
+		query = `DROP INDEX IF EXISTS idx_pull_requests_global_id`
+	}
+	if _, err := s.db.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("set team scoped PR ids: %w", duplicateKeyError(err))
+	}
+	return nil
+}
+
+func (s *PostgresStore) AssignReviewer(ctx context.Context, key PRKey, userID string, reason AssignmentReason) (int, bool, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, false, fmt.Errorf("assign reviewer: %w", err)
+	}
+	defer tx.Rollback()
+
+	load, inserted, err := assignReviewer(ctx, tx, key, userID, reason)
+	if err != nil || !inserted {
+		return 0, false, err
+	}
+	if err := logReassignment(ctx, tx, key, nil, userID, reason.Reason); err != nil {
+		return 0, false, fmt.Errorf("assign reviewer: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, false, fmt.Errorf("commit reviewer %s for PR %s: %w", userID, key, err)
+	}
+	return load, true, nil
+}
+
+func (s *PostgresStore) GetPRReviewers(ctx context.Context, key PRKey) ([]User, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT u.user_id, u.username, u.is_active, u.team_name, u.created_at 
+		FROM users u
+		JOIN pr_reviewers pr ON u.user_id = pr.user_id
+		WHERE pr.team_name = $1 AND pr.pull_request_id = $2
+	`
+	rows, err := s.db.QueryContext(ctx, query, key.TeamName, key.PullRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("get reviewers of PR %s: %w", key, err)
+	}
+	defer rows.Close()
+
+	users, err := s.scanUsers(rows)
+	if err != nil {
+		return nil, fmt.Errorf("get reviewers of PR %s: %w", key, err)
+	}
+	return users, nil
+}
+
+func (s *PostgresStore) GetRecentAuthorReviewers(ctx context.Context, authorID string, limit int) ([]string, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT DISTINCT r.user_id
+		FROM pr_reviewers r
+		WHERE (r.team_name, r.pull_request_id) IN (
+			SELECT team_name, pull_request_id FROM pull_requests
+			WHERE author_id = $1
+			ORDER BY created_at DESC
+			LIMIT $2
+		)
+		ORDER BY r.user_id
+	`
+	rows, err := s.db.QueryContext(ctx, query, authorID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("get recent reviewers of author %s: %w", authorID, err)
+	}
+	defer rows.Close()
+
+	var userIDs []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("get recent reviewers of author %s: %w", authorID, err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get recent reviewers of author %s: %w", authorID, err)
+	}
+	return userIDs, nil
+}
+
+func (s *PostgresStore) RemoveReviewer(ctx context.Context, key PRKey, userID string) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `DELETE FROM pr_reviewers WHERE team_name = $1 AND pull_request_id = $2 AND user_id = $3`
+	if _, err := s.db.ExecContext(ctx, query, key.TeamName, key.PullRequestID, userID); err != nil {
+		return fmt.Errorf("remove reviewer %s from PR %s: %w", userID, key, err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) GetUserAssignedPRs(ctx context.Context, userID string, status *PullRequestStatus, limit, offset int) ([]PullRequest, int, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	var statusFilter sql.NullString
+	if status != nil {
+		statusFilter = sql.NullString{String: string(*status), Valid: true}
+	}
+	from := `
+		FROM pull_requests p
+		JOIN pr_reviewers pr ON p.team_name = pr.team_name AND p.pull_request_id = pr.pull_request_id
+		WHERE pr.user_id = $1 AND ($2::varchar IS NULL OR p.status = $2)
+	`
+
+	var total int
+	if err := s.db.QueryRowContext(ctx, `SELECT COUNT(*) `+from, userID, statusFilter).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count PRs assigned to %s: %w", userID, err)
+	}
+
+	query := `SELECT ` + prColumnsAliased + from + `
+		ORDER BY p.created_at DESC, p.pull_request_id
+		LIMIT NULLIF($3, 0) OFFSET $4
+	`
+	rows, err := s.db.QueryContext(ctx, query, userID, statusFilter, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get PRs assigned to %s: %w", userID, err)
+	}
+	defer rows.Close()
+
+	prs, err := s.scanPRs(rows)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get PRs assigned to %s: %w", userID, err)
+	}
+	return prs, total, nil
+}
+
+func (s *PostgresStore) scanUsers(rows *sql.Rows) ([]User, error) {
+	var users []User
+	for rows.Next() {
+		var user User
+		err := rows.Scan(&user.UserID, &user.Username, &user.IsActive, &user.TeamName, &user.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("scan users: %w", err)
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("scan users: %w", err)
+	}
+	return users, nil
+}
+
+func (s *PostgresStore) scanPRs(rows *sql.Rows) ([]PullRequest, error) {
+	var prs []PullRequest
+	for rows.Next() {
+		pr, err := scanPR(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan PRs: %w", err)
+		}
+		prs = append(prs, *pr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("scan PRs: %w", err)
+	}
+	return prs, nil
+}
+
+func scanPR(row rowScanner) (*PullRequest, error) {
+	var pr PullRequest
+	var mergedAt, reviewDeadline sql.NullTime
+	var teamName sql.NullString
+	err := row.Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &mergedAt, &reviewDeadline, &teamName)
+	if err != nil {
+		return nil, err
+	}
+	if mergedAt.Valid {
+		pr.MergedAt = &mergedAt.Time
+	}
+	if reviewDeadline.Valid {
+		pr.ReviewDeadline = &reviewDeadline.Time
+	}
+	pr.TeamName = teamName.String
+	return &pr, nil
+}
+
+func duplicateKeyError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == pqUniqueViolation {
+		return fmt.Errorf("%w: %s", ErrDuplicateKey, pqErr.Constraint)
+	}
+	return err
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func createTeam(ctx context.Context, db execer, team *Team) error {
+	query := `INSERT INTO teams (name, created_at, required_reviewers) VALUES ($1, $2, $3)`
+	if _, err := db.ExecContext(ctx, query, team.Name, time.Now(), team.RequiredReviewers); err != nil {
+		return fmt.Errorf("create team %s: %w", team.Name, duplicateKeyError(err))
+	}
+	return nil
+}
+
+func upsertUser(ctx context.Context, db execer, user *User) error {
+	query := `
+		INSERT INTO users (user_id, username, is_active, team_name, created_at) 
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id) 
+		DO UPDATE SET username = $2, is_active = $3, team_name = $4
+	`
+	_, err := db.ExecContext(ctx, query,
+		user.UserID, user.Username, user.IsActive, user.TeamName, time.Now())
+	if err != nil {
+		return fmt.Errorf("upsert user %s: %w", user.UserID, err)
+	}
+	return nil
+}
+
+func createPR(ctx context.Context, db execer, pr *PullRequest) error {
+	query := `
+		INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, created_at, review_deadline, team_name) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err := db.ExecContext(ctx, query,
+		pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status, time.Now(), pr.ReviewDeadline, nullString(pr.TeamName))
+	if err != nil {
+		return fmt.Errorf("create PR %s: %w", pr.Key(), duplicateKeyError(err))
+	}
+	return nil
+}
+
+func assignReviewer(ctx context.Context, db rowQuerier, key PRKey, userID string, reason AssignmentReason) (int, bool, error) {
+	query := `
+		INSERT INTO pr_reviewers (team_name, pull_request_id, user_id, assigned_at,
+			assignment_reason, assignment_strategy, assignment_detail, load_at_assignment)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, (
+			SELECT COUNT(*)
+			FROM pr_reviewers r
+			JOIN pull_requests p ON p.team_name = r.team_name AND p.pull_request_id = r.pull_request_id
+			WHERE r.user_id = $3 AND p.status = $8
+		))
+		ON CONFLICT (team_name, pull_request_id, user_id) DO NOTHING
+		RETURNING load_at_assignment
+	`
+	var load int
+	err := db.QueryRowContext(ctx, query, key.TeamName, key.PullRequestID, userID, time.Now(),
+		reason.Reason, reason.Strategy, reason.Detail, PRStatusOpen).Scan(&load)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("assign reviewer %s to PR %s: %w", userID, key, err)
+	}
+	return load, true, nil
+}
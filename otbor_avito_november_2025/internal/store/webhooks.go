@@ -0,0 +1,87 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type WebhookSubscription struct {
+	ID        int64     `json:"id"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"-"`
+	Events    []string  `json:"events"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (s *PostgresStore) CreateWebhookSubscription(ctx context.Context, sub *WebhookSubscription) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO webhook_subscriptions (url, secret, events, created_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`
+	return s.db.QueryRowContext(ctx, query, sub.URL, sub.Secret, pq.Array(sub.Events), sub.CreatedAt).Scan(&sub.ID)
+}
+
+func (s *PostgresStore) GetWebhookSubscriptions(ctx context.Context) ([]WebhookSubscription, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `SELECT id, url, secret, events, created_at FROM webhook_subscriptions ORDER BY id`
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("get webhook subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	var subs []WebhookSubscription
+	for rows.Next() {
+		var sub WebhookSubscription
+		if err := rows.Scan(&sub.ID, &sub.URL, &sub.Secret, pq.Array(&sub.Events), &sub.CreatedAt); err != nil {
+			return nil, fmt.Errorf("get webhook subscriptions: %w", err)
+		}
+		subs = append(subs, sub)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get webhook subscriptions: %w", err)
+	}
+	return subs, nil
+}
+
+func (s *PostgresStore) GetWebhookSubscription(ctx context.Context, id int64) (*WebhookSubscription, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `SELECT id, url, secret, events, created_at FROM webhook_subscriptions WHERE id = $1`
+	var sub WebhookSubscription
+	err := s.db.QueryRowContext(ctx, query, id).Scan(&sub.ID, &sub.URL, &sub.Secret, pq.Array(&sub.Events), &sub.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get webhook subscription: %w", err)
+	}
+	return &sub, nil
+}
+
+func (s *PostgresStore) DeleteWebhookSubscription(ctx context.Context, id int64) (bool, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	result, err := s.db.ExecContext(ctx, `DELETE FROM webhook_subscriptions WHERE id = $1`, id)
+	if err != nil {
+		return false, fmt.Errorf("delete webhook subscription: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("delete webhook subscription: %w", err)
+	}
+	return deleted > 0, nil
+}
@@ -0,0 +1,52 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+func (s *PostgresStore) GetReviewWeights(ctx context.Context, userIDs []string, now time.Time) (map[string]float64, error) {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `
+		SELECT user_id,
+		       review_weight * CASE WHEN boost_expires_at > $2 THEN boost_factor ELSE 1 END
+		FROM users
+		WHERE user_id = ANY($1)
+	`
+	rows, err := s.db.QueryContext(ctx, query, pq.Array(userIDs), now)
+	if err != nil {
+		return nil, fmt.Errorf("get review weights: %w", err)
+	}
+	defer rows.Close()
+
+	weights := make(map[string]float64, len(userIDs))
+	for rows.Next() {
+		var userID string
+		var weight float64
+		if err := rows.Scan(&userID, &weight); err != nil {
+			return nil, fmt.Errorf("get review weights: %w", err)
+		}
+		weights[userID] = weight
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("get review weights: %w", err)
+	}
+	return weights, nil
+}
+
+func (s *PostgresStore) SetUserBoost(ctx context.Context, userID string, factor float64, expiresAt time.Time) error {
+	ctx, cancel := s.queryContext(ctx)
+	defer cancel()
+
+	query := `UPDATE users SET boost_factor = $1, boost_expires_at = $2 WHERE user_id = $3`
+	_, err := s.db.ExecContext(ctx, query, factor, expiresAt, userID)
+	if err != nil {
+		return fmt.Errorf("set user boost: %w", err)
+	}
+	return nil
+}
@@ -1,28 +1,226 @@
-CREATE TABLE IF NOT EXISTS teams (
-    name VARCHAR(100) PRIMARY KEY,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-);
-
-CREATE TABLE IF NOT EXISTS users (
-    user_id VARCHAR(100) PRIMARY KEY,
-    username VARCHAR(100) NOT NULL,
-    is_active BOOLEAN DEFAULT TRUE NOT NULL,
-    team_name VARCHAR(100) NOT NULL REFERENCES teams(name) ON DELETE CASCADE,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-);
-
-CREATE TABLE IF NOT EXISTS pull_requests (
-    pull_request_id VARCHAR(100) PRIMARY KEY,
-    pull_request_name VARCHAR(200) NOT NULL,
-    author_id VARCHAR(100) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
-    status VARCHAR(20) DEFAULT 'OPEN' NOT NULL CHECK (status IN ('OPEN', 'MERGED')),
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    merged_at TIMESTAMP NULL
-);
-
-CREATE TABLE IF NOT EXISTS pr_reviewers (
-    pull_request_id VARCHAR(100) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
-    user_id VARCHAR(100) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
-    assigned_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    PRIMARY KEY (pull_request_id, user_id)
+CREATE TABLE IF NOT EXISTS teams (
+    name VARCHAR(100) PRIMARY KEY,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    default_weekly_quota INTEGER NULL CHECK (default_weekly_quota >= 0),
+    required_reviewers INTEGER DEFAULT 2 NOT NULL CHECK (required_reviewers >= 1)
+);
+
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS default_weekly_quota INTEGER NULL CHECK (default_weekly_quota >= 0);
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS required_reviewers INTEGER DEFAULT 2 NOT NULL CHECK (required_reviewers >= 1);
+
+CREATE TABLE IF NOT EXISTS users (
+    user_id VARCHAR(100) PRIMARY KEY,
+    username VARCHAR(100) NOT NULL,
+    is_active BOOLEAN DEFAULT TRUE NOT NULL,
+    team_name VARCHAR(100) NOT NULL REFERENCES teams(name) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    review_weight DOUBLE PRECISION DEFAULT 1 NOT NULL,
+    boost_factor DOUBLE PRECISION NULL,
+    boost_expires_at TIMESTAMP NULL,
+    weekly_quota INTEGER NULL CHECK (weekly_quota >= 0)
+);
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS review_weight DOUBLE PRECISION DEFAULT 1 NOT NULL;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS boost_factor DOUBLE PRECISION NULL;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS boost_expires_at TIMESTAMP NULL;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS weekly_quota INTEGER NULL CHECK (weekly_quota >= 0);
+
+CREATE TABLE IF NOT EXISTS pull_requests (
+    pull_request_id VARCHAR(100) NOT NULL,
+    pull_request_name VARCHAR(200) NOT NULL,
+    author_id VARCHAR(100) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    status VARCHAR(20) DEFAULT 'OPEN' NOT NULL CHECK (status IN ('OPEN', 'MERGED', 'CLOSED')),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    merged_at TIMESTAMP NULL,
+    review_deadline TIMESTAMP NULL,
+    updated_at TIMESTAMP NULL,
+    team_name VARCHAR(100) NOT NULL REFERENCES teams(name),
+    PRIMARY KEY (team_name, pull_request_id)
+);
+
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS review_deadline TIMESTAMP NULL;
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP NULL;
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS team_name VARCHAR(100) NULL REFERENCES teams(name);
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS pull_requests_team_name_fkey;
+ALTER TABLE pull_requests ADD CONSTRAINT pull_requests_team_name_fkey FOREIGN KEY (team_name) REFERENCES teams(name);
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS pull_requests_status_check;
+ALTER TABLE pull_requests ADD CONSTRAINT pull_requests_status_check CHECK (status IN ('OPEN', 'MERGED', 'CLOSED'));
+
+UPDATE pull_requests p SET team_name = u.team_name
+FROM users u
+WHERE p.team_name IS NULL AND u.user_id = p.author_id;
+ALTER TABLE pull_requests ALTER COLUMN team_name SET NOT NULL;
+
+DROP INDEX IF EXISTS idx_pull_requests_team_pull_request;
+
+CREATE TABLE IF NOT EXISTS pr_reviewers (
+    team_name VARCHAR(100) NOT NULL,
+    pull_request_id VARCHAR(100) NOT NULL,
+    user_id VARCHAR(100) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    assigned_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    acknowledged_at TIMESTAMP NULL,
+    assignment_reason VARCHAR(30) NULL,
+    assignment_strategy VARCHAR(20) NULL,
+    assignment_detail TEXT NULL,
+    load_at_assignment INTEGER NULL,
+    PRIMARY KEY (team_name, pull_request_id, user_id),
+    FOREIGN KEY (team_name, pull_request_id) REFERENCES pull_requests(team_name, pull_request_id) ON DELETE CASCADE
+);
+
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS acknowledged_at TIMESTAMP NULL;
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS assignment_reason VARCHAR(30) NULL;
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS assignment_strategy VARCHAR(20) NULL;
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS assignment_detail TEXT NULL;
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS load_at_assignment INTEGER NULL;
+
+CREATE TABLE IF NOT EXISTS pr_approvals (
+    team_name VARCHAR(100) NOT NULL,
+    pull_request_id VARCHAR(100) NOT NULL,
+    user_id VARCHAR(100) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    approved_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (team_name, pull_request_id, user_id),
+    FOREIGN KEY (team_name, pull_request_id) REFERENCES pull_requests(team_name, pull_request_id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS pr_escalations (
+    team_name VARCHAR(100) NOT NULL,
+    pull_request_id VARCHAR(100) NOT NULL,
+    user_id VARCHAR(100) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    escalated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (team_name, pull_request_id, user_id),
+    FOREIGN KEY (team_name, pull_request_id) REFERENCES pull_requests(team_name, pull_request_id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS pending_assignments (
+    team_name VARCHAR(100) NOT NULL,
+    pull_request_id VARCHAR(100) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (team_name, pull_request_id),
+    FOREIGN KEY (team_name, pull_request_id) REFERENCES pull_requests(team_name, pull_request_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_pending_assignments_next_attempt ON pending_assignments(next_attempt_at);
+
+CREATE TABLE IF NOT EXISTS reassignment_log (
+    id BIGSERIAL PRIMARY KEY,
+    team_name VARCHAR(100) NOT NULL,
+    pull_request_id VARCHAR(100) NOT NULL,
+    old_user_id VARCHAR(100) NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    new_user_id VARCHAR(100) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    reason VARCHAR(30) NULL,
+    reassigned_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (team_name, pull_request_id) REFERENCES pull_requests(team_name, pull_request_id) ON DELETE CASCADE
+);
+
+ALTER TABLE reassignment_log ALTER COLUMN old_user_id DROP NOT NULL;
+ALTER TABLE reassignment_log ADD COLUMN IF NOT EXISTS reason VARCHAR(30) NULL;
+
+-- Databases created before PRs were keyed by (team_name, pull_request_id)
+-- carry the team on pull_requests only: copy it to the child tables and move
+-- their keys over to the composite one.
+DO $$
+DECLARE
+    child TEXT;
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.key_column_usage
+        WHERE table_schema = current_schema() AND table_name = 'pull_requests'
+          AND constraint_name = 'pull_requests_pkey' AND column_name = 'team_name'
+    ) THEN
+        RETURN;
+    END IF;
+
+    FOREACH child IN ARRAY ARRAY['pr_reviewers', 'pr_approvals', 'pr_escalations', 'pending_assignments', 'reassignment_log'] LOOP
+        EXECUTE format('ALTER TABLE %I ADD COLUMN IF NOT EXISTS team_name VARCHAR(100) NULL', child);
+        EXECUTE format('UPDATE %I c SET team_name = p.team_name FROM pull_requests p WHERE p.pull_request_id = c.pull_request_id', child);
+        EXECUTE format('ALTER TABLE %I ALTER COLUMN team_name SET NOT NULL', child);
+        EXECUTE format('ALTER TABLE %I DROP CONSTRAINT IF EXISTS %I', child, child || '_pull_request_id_fkey');
+    END LOOP;
+
+    ALTER TABLE pull_requests DROP CONSTRAINT pull_requests_pkey;
+    ALTER TABLE pull_requests ADD PRIMARY KEY (team_name, pull_request_id);
+
+    ALTER TABLE pr_reviewers DROP CONSTRAINT pr_reviewers_pkey;
+    ALTER TABLE pr_reviewers ADD PRIMARY KEY (team_name, pull_request_id, user_id);
+    ALTER TABLE pr_approvals DROP CONSTRAINT pr_approvals_pkey;
+    ALTER TABLE pr_approvals ADD PRIMARY KEY (team_name, pull_request_id, user_id);
+    ALTER TABLE pr_escalations DROP CONSTRAINT pr_escalations_pkey;
+    ALTER TABLE pr_escalations ADD PRIMARY KEY (team_name, pull_request_id, user_id);
+    ALTER TABLE pending_assignments DROP CONSTRAINT pending_assignments_pkey;
+    ALTER TABLE pending_assignments ADD PRIMARY KEY (team_name, pull_request_id);
+
+    FOREACH child IN ARRAY ARRAY['pr_reviewers', 'pr_approvals', 'pr_escalations', 'pending_assignments', 'reassignment_log'] LOOP
+        EXECUTE format('ALTER TABLE %I ADD FOREIGN KEY (team_name, pull_request_id) REFERENCES pull_requests(team_name, pull_request_id) ON DELETE CASCADE', child);
+    END LOOP;
+END $$;
+
+DROP INDEX IF EXISTS idx_reassignment_log_pull_request;
+CREATE INDEX IF NOT EXISTS idx_reassignment_log_team_pull_request ON reassignment_log(team_name, pull_request_id);
+
+CREATE TABLE IF NOT EXISTS team_blackouts (
+    id BIGSERIAL PRIMARY KEY,
+    team_name VARCHAR(100) NOT NULL REFERENCES teams(name) ON DELETE CASCADE,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    recurrence VARCHAR(20) DEFAULT 'none' NOT NULL CHECK (recurrence IN ('none', 'weekly')),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (ends_at > starts_at)
+);
+
+CREATE TABLE IF NOT EXISTS team_path_owners (
+    team_name VARCHAR(100) NOT NULL REFERENCES teams(name) ON DELETE CASCADE,
+    pattern VARCHAR(255) NOT NULL,
+    user_id VARCHAR(100) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    PRIMARY KEY (team_name, pattern, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS team_fallbacks (
+    team_name VARCHAR(100) NOT NULL REFERENCES teams(name) ON DELETE CASCADE,
+    fallback_team_name VARCHAR(100) NOT NULL REFERENCES teams(name) ON DELETE CASCADE,
+    PRIMARY KEY (team_name, fallback_team_name),
+    CHECK (team_name <> fallback_team_name)
+);
+
+CREATE TABLE IF NOT EXISTS user_skills (
+    user_id VARCHAR(100) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    skill VARCHAR(100) NOT NULL,
+    PRIMARY KEY (user_id, skill)
+);
+
+CREATE TABLE IF NOT EXISTS user_api_keys (
+    key_hash CHAR(64) PRIMARY KEY,
+    user_id VARCHAR(100) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    last_used_at TIMESTAMP,
+    revoked_at TIMESTAMP
+);
+
+ALTER TABLE user_api_keys ADD COLUMN IF NOT EXISTS last_used_at TIMESTAMP;
+ALTER TABLE user_api_keys ADD COLUMN IF NOT EXISTS revoked_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_user_api_keys_user ON user_api_keys(user_id);
+
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id BIGSERIAL PRIMARY KEY,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    events TEXT[] NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS team_pool_snapshots (
+    team_name VARCHAR(100) NOT NULL REFERENCES teams(name) ON DELETE CASCADE,
+    taken_at TIMESTAMP NOT NULL,
+    active_members INTEGER NOT NULL,
+    available_members INTEGER NOT NULL,
+    PRIMARY KEY (team_name, taken_at)
+);
+
+CREATE TABLE IF NOT EXISTS feature_flags (
+    name VARCHAR(100) PRIMARY KEY,
+    enabled BOOLEAN DEFAULT FALSE NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
\ No newline at end of file
@@ -1,37 +1,236 @@
-package main
-
-import (
-	"database/sql"
-	"log"
-
-	"otbor_avito_november_2025/internal/api"
-	"otbor_avito_november_2025/internal/handlers"
-	"otbor_avito_november_2025/internal/service"
-	"otbor_avito_november_2025/internal/store"
-
-	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
-	_ "github.com/lib/pq"
-)
-
-func main() {
-	dsn := "host=postgres user=postgres password=postgres dbname=otbor_avito port=5432 sslmode=disable"
-	db, err := sql.Open("postgres", dsn)
-	if err != nil {
-		log.Fatal("Failed to connect to database:", err)
-	}
-	defer db.Close()
-	if err := db.Ping(); err != nil {
-		log.Fatal("Failed to ping database:", err)
-	}
-	store := store.NewPostgresStore(db)
-	service := service.NewService(store)
-	handler := handlers.NewHandler(service)
-	e := echo.New()
-	e.Use(middleware.Logger())
-	e.Use(middleware.Recover())
-
-	api.RegisterHandlers(e, handler)
-	log.Println("Server starting on :8080")
-	e.Logger.Fatal(e.Start(":8080"))
-}
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"otbor_avito_november_2025/internal/api"
+	"otbor_avito_november_2025/internal/handlers"
+	"otbor_avito_november_2025/internal/service"
+	"otbor_avito_november_2025/internal/store"
+	"otbor_avito_november_2025/migrations"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	_ "github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+func main() {
+	st, closeStore := openStore(getEnv("STORE", "postgres"))
+	defer closeStore()
+
+	envFlags := make(map[string]bool)
+	for _, name := range service.KnownFlags {
+		if enabled, err := strconv.ParseBool(getEnv("FEATURE_"+strings.ToUpper(name), "")); err == nil {
+			envFlags[name] = enabled
+		}
+	}
+	flags := service.NewFeatureFlags(st, envFlags)
+
+	webhooks := service.NewWebhookDispatcher(service.WebhookConfig{
+		Attempts: getEnvInt("WEBHOOK_ATTEMPTS", 5),
+		Backoff:  getEnvDuration("WEBHOOK_BACKOFF", time.Second),
+	})
+	var shutdownDeadline time.Time
+	webhooks.Start()
+	defer func() {
+		ctx, cancel := context.WithDeadline(context.Background(), shutdownDeadline)
+		defer cancel()
+		webhooks.Stop(ctx)
+	}()
+
+	svc := service.NewService(st,
+		service.WithFeatureFlags(flags),
+		service.WithAssignmentStrategy(getEnv("ASSIGNMENT_STRATEGY", service.StrategyLeastLoaded)),
+		service.WithDefaultTeam(getEnv("DEFAULT_TEAM", "")),
+		service.WithAssignmentRetry(service.AssignmentRetryConfig{
+			Window:   getEnvDuration("ASSIGNMENT_RETRY_WINDOW", 0),
+			Attempts: getEnvInt("ASSIGNMENT_RETRY_ATTEMPTS", 3),
+		}),
+		service.WithCreateRateLimit(getEnvInt("CREATE_RATE_LIMIT_PER_MINUTE", 0), getEnvInt("CREATE_RATE_LIMIT_BURST", 1)),
+		service.WithWebhookDispatcher(webhooks),
+		service.WithAssignmentWebhook(getEnv("ASSIGNMENT_WEBHOOK_URL", ""), getEnv("ASSIGNMENT_WEBHOOK_SECRET", "")),
+		service.WithMetrics(service.NewMetrics(prometheus.DefaultRegisterer)),
+		service.WithRecentReviewerWindow(getEnvInt("RECENT_REVIEWER_WINDOW", 3)),
+	)
+	if err := svc.ValidateDefaultTeam(context.Background()); err != nil {
+		log.Fatal("Invalid default team:", err)
+	}
+	handler := handlers.NewHandler(svc)
+
+	escalationConfig := service.EscalationConfig{
+		Interval:       getEnvDuration("ESCALATION_INTERVAL", time.Minute),
+		ExtraReviewers: getEnvInt("ESCALATION_EXTRA_REVIEWERS", 1),
+	}
+	if escalationConfig.Interval > 0 {
+		worker := service.NewEscalationWorker(svc, escalationConfig)
+		worker.Start()
+		defer worker.Stop()
+	}
+
+	if interval := getEnvDuration("POOL_SNAPSHOT_INTERVAL", 24*time.Hour); interval > 0 {
+		worker := service.NewPoolSnapshotWorker(svc, interval)
+		worker.Start()
+		defer worker.Stop()
+	}
+
+	if svc.AssignmentRetry().Enabled() {
+		worker := service.NewAssignmentRetryWorker(svc)
+		worker.Start()
+		defer worker.Stop()
+	}
+
+	e := echo.New()
+	e.HTTPErrorHandler = handlers.HTTPErrorHandler
+	e.Use(middleware.Logger())
+	e.Use(middleware.Recover())
+
+	adminTokens := handlers.NewAdminTokens(loadAdminTokens())
+	if adminTokens.Len() == 0 {
+		log.Println("No admin tokens configured, /admin endpoints will reject all requests")
+	}
+	go reloadAdminTokensOnHangup(adminTokens)
+
+	concurrencyLimits := handlers.NewConcurrencyLimits(getEnv("CONCURRENCY_LIMITS", ""))
+	if concurrencyLimits.Len() > 0 {
+		e.Use(handlers.ConcurrencyLimit(concurrencyLimits))
+	}
+	e.Use(handlers.Authenticate(adminTokens, svc))
+	e.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
+
+	api.RegisterHandlers(handlers.NewAdminRouter(e, handlers.AdminAuth(adminTokens)), handler)
+	addr := getEnv("SERVER_ADDR", ":8080")
+	go func() {
+		log.Println("Server starting on", addr)
+		if err := e.Start(addr); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal("Server failed:", err)
+		}
+	}()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	<-stop
+
+	log.Println("Shutting down server")
+	shutdownDeadline = time.Now().Add(getEnvDuration("SHUTDOWN_TIMEOUT", 10*time.Second))
+	ctx, cancel := context.WithDeadline(context.Background(), shutdownDeadline)
+	defer cancel()
+	if err := e.Shutdown(ctx); err != nil {
+		log.Println("Failed to shut down server gracefully:", err)
+	}
+}
+
+func openStore(kind string) (store.Store, func()) {
+	teamScoped := teamScopedPRIDs(getEnv("PR_ID_SCOPE", "global"))
+	if kind == "memory" {
+		log.Println("Using in-memory store, data will be lost on restart")
+		st := store.NewMemoryStore()
+		if err := st.SetTeamScopedPRIDs(context.Background(), teamScoped); err != nil {
+			log.Fatal("Failed to configure PR id scope:", err)
+		}
+		return st, func() {}
+	}
+
+	db, err := sql.Open("postgres", databaseDSN())
+	if err != nil {
+		log.Fatal("Failed to connect to database:", err)
+	}
+	db.SetMaxOpenConns(getEnvInt("DB_MAX_OPEN_CONNS", 25))
+	db.SetMaxIdleConns(getEnvInt("DB_MAX_IDLE_CONNS", 25))
+	db.SetConnMaxLifetime(getEnvDuration("DB_CONN_MAX_LIFETIME", 5*time.Minute))
+	if err := db.Ping(); err != nil {
+		log.Fatal("Failed to ping database:", err)
+	}
+	if err := migrations.Run(context.Background(), db); err != nil {
+		log.Fatal("Failed to run database migrations:", err)
+	}
+	log.Println("Database migrations applied")
+	st := store.NewPostgresStore(db, getEnvDuration("DB_QUERY_TIMEOUT", 5*time.Second))
+	if err := st.SetTeamScopedPRIDs(context.Background(), teamScoped); err != nil {
+		log.Fatal("Failed to configure PR id scope:", err)
+	}
+	return st, func() { db.Close() }
+}
+
+// teamScopedPRIDs parses PR_ID_SCOPE: "global" keeps PR ids unique across
+// all teams, "team" lets every team use its own ids.
+func teamScopedPRIDs(scope string) bool {
+	switch scope {
+	case "global":
+		return false
+	case "team":
+		return true
+	default:
+		log.Fatalf("Invalid PR_ID_SCOPE %q, expected \"global\" or \"team\"", scope)
+		return false
+	}
+}
+
+func databaseDSN() string {
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(getEnv("DB_USER", "postgres"), getEnv("DB_PASSWORD", "postgres")),
+		Host:     net.JoinHostPort(getEnv("DB_HOST", "postgres"), getEnv("DB_PORT", "5432")),
+		Path:     "/" + getEnv("DB_NAME", "otbor_avito"),
+		RawQuery: url.Values{"sslmode": {getEnv("DB_SSLMODE", "disable")}}.Encode(),
+	}
+	return dsn.String()
+}
+
+func loadAdminTokens() string {
+	path := getEnv("ADMIN_TOKENS_FILE", "")
+	if path == "" {
+		return getEnv("ADMIN_TOKENS", "")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		log.Println("Failed to read admin tokens file:", err)
+		return ""
+	}
+	return strings.ReplaceAll(string(data), "\n", ",")
+}
+
+func reloadAdminTokensOnHangup(tokens *handlers.AdminTokens) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	for range hangup {
+		tokens.Set(loadAdminTokens())
+		log.Printf("Reloaded admin tokens: %d configured", tokens.Len())
+	}
+}
+
+func getEnv(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		return value
+	}
+	return fallback
+}
+
+func getEnvInt(key string, fallback int) int {
+	value, err := strconv.Atoi(getEnv(key, ""))
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	value, err := time.ParseDuration(getEnv(key, ""))
+	if err != nil {
+		return fallback
+	}
+	return value
+}